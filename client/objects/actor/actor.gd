@@ -7,6 +7,14 @@ const Actor := preload("res://objects/actor/actor.gd")
 
 var actor_id: int
 var actor_name: String
+var badge: String:
+	set(new_badge):
+		badge = new_badge
+		_update_nameplate()
+var level: int:
+	set(new_level):
+		level = new_level
+		_update_nameplate()
 var start_x: float
 var start_y: float
 var start_rad: float
@@ -29,10 +37,12 @@ var radius: float:
 @onready var _collision_shape: CircleShape2D = $CollisionShape2D.shape
 @onready var _camera: Camera2D = $Camera2D
 
-static func instatiate(actor_id: int, actor_name: String, x: float, y: float, rad: float, speed: float, color: Color, is_player: bool) -> Actor:
+static func instatiate(actor_id: int, actor_name: String, badge: String, level: int, x: float, y: float, rad: float, speed: float, color: Color, is_player: bool) -> Actor:
 	var actor := Scene.instantiate()
 	actor.actor_id = actor_id
 	actor.actor_name = actor_name
+	actor.badge = badge
+	actor.level = level
 	actor.start_x = x
 	actor.start_y = y
 	actor.start_rad = rad
@@ -51,7 +61,7 @@ func _ready():
 	radius = start_rad
 	
 	_collision_shape.radius = radius
-	_nameplate.text = actor_name
+	_update_nameplate()
 	
 func _process(delta: float) -> void:
 	if not is_equal_approx(_camera.zoom.x, _target_zoom):
@@ -76,6 +86,15 @@ func _physics_process(delta: float) -> void:
 		player_direction_msg.set_direction(velocity.angle())
 		WS.send(packet)
 		
+func _update_nameplate() -> void:
+	if not is_node_ready():
+		return
+	
+	var text := "%s (lv %d)" % [actor_name, level]
+	if badge:
+		text = "[%s] %s" % [badge, text]
+	_nameplate.text = text
+	
 func _update_zoom() -> void:
 	if is_node_ready():
 		_nameplate.add_theme_font_size_override("font_size", max(16, radius / 2))
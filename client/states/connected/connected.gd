@@ -25,6 +25,13 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 		_log.error(deny_response_msg.get_reason())
 	elif packet.has_ok_response():
 		_action_on_ok_received.call()
+	elif packet.has_chat():
+		# Global chat and system messages reach us before we've joined the game too
+		var chat_msg := packet.get_chat()
+		if chat_msg.get_channel() == packets.ChatChannel.CHAT_CHANNEL_SYSTEM:
+			_log.info(chat_msg.get_msg())
+		else:
+			_log.chat(chat_msg.get_sender_name(), chat_msg.get_msg())
 	
 func _on_ws_connection_closed() -> void:
 	_log.warning("Connection closed")
@@ -24,9 +24,24 @@ func _ready() -> void:
 	_line_edit.text_submitted.connect(_on_line_edit_text_submitted)
 
 func _handle_chat_msg(sender_id: int, chat_msg: packets.ChatMessage) -> void:
-	if sender_id in _players:
-		var actor := _players[sender_id]
-		_log.chat(actor.actor_name, chat_msg.get_msg())
+	# The server fills in who sent the message, which also covers chat replayed from before we joined
+	match chat_msg.get_channel():
+		packets.ChatChannel.CHAT_CHANNEL_SYSTEM:
+			_log.info(chat_msg.get_msg())
+		packets.ChatChannel.CHAT_CHANNEL_WHISPER:
+			_log.chat("%s whispers" % chat_msg.get_sender_name(), chat_msg.get_msg())
+		_:
+			_log.chat(chat_msg.get_sender_name(), chat_msg.get_msg())
+
+func _handle_command_response_msg(sender_id: int, command_response_msg: packets.CommandResponseMessage) -> void:
+	for line in command_response_msg.get_lines():
+		if command_response_msg.get_success():
+			_log.info(line)
+		else:
+			_log.error(line)
+
+func _handle_deny_response_msg(sender_id: int, deny_response_msg: packets.DenyResponseMessage) -> void:
+	_log.error(deny_response_msg.get_reason())
 	
 func _on_logout_button_pressed() -> void:
 	var packet := packets.Packet.new()
@@ -46,7 +61,7 @@ func _on_line_edit_text_submitted(new_text: String) -> void:
 	var err := WS.send(packet)
 	if err:
 		_log.error("Error sending chat message")
-	else:
+	elif not new_text.begins_with("/"):
 		_log.chat("You", new_text)
 	_line_edit.clear()
 	
@@ -68,10 +83,16 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 		_handle_spore_consumed_msg(sender_id, packet.get_spore_consumed())
 	elif packet.has_disconnect():
 		_handle_disconnect_msg(sender_id, packet.get_disconnect())
+	elif packet.has_command_response():
+		_handle_command_response_msg(sender_id, packet.get_command_response())
+	elif packet.has_deny_response():
+		_handle_deny_response_msg(sender_id, packet.get_deny_response())
 	
 func _handle_player_msg(sender_id: int, player_msg: packets.PlayerMessage) -> void:
 	var actor_id := player_msg.get_id()
 	var actor_name := player_msg.get_name()
+	var badge := player_msg.get_badge()
+	var level := player_msg.get_level()
 	var x := player_msg.get_x()
 	var y := player_msg.get_y()
 	var radius := player_msg.get_radius()
@@ -82,14 +103,14 @@ func _handle_player_msg(sender_id: int, player_msg: packets.PlayerMessage) -> vo
 	var is_player := actor_id == GameManager.client_id
 	
 	if actor_id not in _players:
-		_add_actor(actor_id, actor_name, x, y, radius, speed, color, is_player)
+		_add_actor(actor_id, actor_name, badge, level, x, y, radius, speed, color, is_player)
 	else:
 		var direction := player_msg.get_direction()
-		_update_actor(actor_id, actor_name, x, y, direction, radius, speed, is_player)
+		_update_actor(actor_id, actor_name, badge, level, x, y, direction, radius, speed, is_player)
 
-func _add_actor(actor_id: int, actor_name: String, x: float, y: float, radius: float, speed: float, color: Color, is_player: bool) -> void:
+func _add_actor(actor_id: int, actor_name: String, badge: String, level: int, x: float, y: float, radius: float, speed: float, color: Color, is_player: bool) -> void:
 	# This is a new player, so we need to create a new actor
-	var actor := Actor.instatiate(actor_id, actor_name, x, y, radius, speed, color, is_player)
+	var actor := Actor.instatiate(actor_id, actor_name, badge, level, x, y, radius, speed, color, is_player)
 	_world.add_child(actor)
 	actor.z_index = 1
 	_set_actor_mass(actor, _rad_to_mass(radius))
@@ -98,9 +119,13 @@ func _add_actor(actor_id: int, actor_name: String, x: float, y: float, radius: f
 	if is_player:
 		actor.area_entered.connect(_on_player_area_entered)
 	
-func _update_actor(actor_id: int, actor_name: String, x: float, y: float, direction: float, radius: float, speed: float, is_player: bool) -> void:
-	# This is an existing player, so we need to update their position
+func _update_actor(actor_id: int, actor_name: String, badge: String, level: int, x: float, y: float, direction: float, radius: float, speed: float, is_player: bool) -> void:
+	# This is an existing player, so we need to update their position, and their level in case they've gone up one
 	var actor := _players[actor_id]
+	if actor.badge != badge:
+		actor.badge = badge
+	if actor.level != level:
+		actor.level = level
 	
 	_set_actor_mass(actor, _rad_to_mass(radius))
 	
@@ -0,0 +1,552 @@
+// Admin is a command line tool for managing the game server's database directly,
+// e.g. to promote a user to moderator. It can be run while the server is up.
+//
+// Usage:
+//
+//	admin [-config .env] <command> [arguments...]
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	_ "modernc.org/sqlite"
+)
+
+type command struct {
+	usage string
+	help  string
+	run   func(dbTx *server.DbTx, args []string) error
+}
+
+// Returned by a command when it's given the wrong arguments
+var errUsage = errors.New("wrong arguments")
+
+var (
+	configPath = flag.String("config", ".env", "Path to the config file")
+	commands   = map[string]command{
+		"set-role": {
+			usage: "set-role <username> <player|moderator|admin>",
+			help:  "Set the role of a user",
+			run:   setRole,
+		},
+		"get-role": {
+			usage: "get-role <username>",
+			help:  "Show the role of a user",
+			run:   getRole,
+		},
+		"ban": {
+			usage: "ban <username> <duration|permanent> <reason...>",
+			help:  "Stop a user from logging in",
+			run:   sanctionUser(server.SanctionBan),
+		},
+		"unban": {
+			usage: "unban <username>",
+			help:  "Lift all bans on a user, and on the IP address they last logged in from",
+			run:   unbanUser,
+		},
+		"mute": {
+			usage: "mute <username> <duration|permanent> <reason...>",
+			help:  "Stop a user from chatting",
+			run:   sanctionUser(server.SanctionMute),
+		},
+		"unmute": {
+			usage: "unmute <username>",
+			help:  "Lift all mutes on a user",
+			run:   revokeUserSanctions(server.SanctionMute),
+		},
+		"ban-ip": {
+			usage: "ban-ip <ip address> <duration|permanent> <reason...>",
+			help:  "Stop anyone logging in or registering from an IP address",
+			run:   banIp,
+		},
+		"unban-ip": {
+			usage: "unban-ip <ip address>",
+			help:  "Lift all bans on an IP address",
+			run:   unbanIp,
+		},
+		"chat-log": {
+			usage: "chat-log <username> [limit]",
+			help:  "Show the most recent chat messages sent by a user",
+			run:   showChatLog,
+		},
+		"reports": {
+			usage: "reports [open|all] [limit]",
+			help:  "List player reports, oldest open ones first by default",
+			run:   listReports,
+		},
+		"report": {
+			usage: "report <id>",
+			help:  "Show a player report in full, including its context",
+			run:   showReport,
+		},
+		"resolve-report": {
+			usage: "resolve-report <id> <resolution...>",
+			help:  "Mark a player report as dealt with",
+			run:   resolveReport,
+		},
+		"start-season": {
+			usage: "start-season <name...>",
+			help:  "End the current season, archiving its standings, and start a new one",
+			run:   startSeason,
+		},
+		"seasons": {
+			usage: "seasons",
+			help:  "List every season, newest first",
+			run:   listSeasons,
+		},
+		"moderation-log": {
+			usage: "moderation-log [limit]",
+			help:  "Show the most recent moderation actions",
+			run:   showModerationLog,
+		},
+	}
+)
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if err := godotenv.Load(*configPath); err != nil {
+		log.Printf("Error loading config file, looking for the database in the current directory")
+	}
+
+	args := flag.Args()
+	if len(args) < 1 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	cmd, exists := commands[args[0]]
+	if !exists {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n", args[0])
+		printUsage()
+		os.Exit(2)
+	}
+
+	dataPath := os.Getenv("DATA_PATH")
+	if dataPath == "" {
+		dataPath = "."
+	}
+
+	dbPool, err := sql.Open("sqlite", path.Join(dataPath, "db.sqlite"))
+	if err != nil {
+		log.Fatalf("Error opening database: %v", err)
+	}
+	defer dbPool.Close()
+
+	ctx := context.Background()
+	if err := server.InitializeDb(ctx, dbPool); err != nil {
+		log.Fatalf("Error initializing database: %v", err)
+	}
+
+	if err := cmd.run(&server.DbTx{Ctx: ctx, Queries: db.New(dbPool)}, args[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "Usage: %s\n", cmd.usage)
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [-config .env] <command> [arguments...]\n\nCommands:\n", path.Base(os.Args[0]))
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-55s %s\n", commands[name].usage, commands[name].help)
+	}
+	fmt.Fprintln(os.Stderr)
+	flag.PrintDefaults()
+}
+
+func getUser(dbTx *server.DbTx, username string) (db.User, error) {
+	user, err := dbTx.Queries.GetUserByUsername(dbTx.Ctx, strings.ToLower(username))
+	if err != nil {
+		return db.User{}, fmt.Errorf("error getting user %s: %w", username, err)
+	}
+	return user, nil
+}
+
+func setRole(dbTx *server.DbTx, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	role, err := server.ParseRole(args[1])
+	if err != nil {
+		return err
+	}
+
+	user, err := getUser(dbTx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := dbTx.Queries.SetUserRole(dbTx.Ctx, db.SetUserRoleParams{UserID: user.ID, Role: string(role)}); err != nil {
+		return fmt.Errorf("error setting role: %w", err)
+	}
+
+	fmt.Printf("%s is now a %s\n", user.Username, role)
+	return nil
+}
+
+func getRole(dbTx *server.DbTx, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	user, err := getUser(dbTx, args[0])
+	if err != nil {
+		return err
+	}
+
+	role, err := server.GetUserRole(dbTx, user.ID)
+	if err != nil {
+		return fmt.Errorf("error getting role: %w", err)
+	}
+
+	fmt.Printf("%s is a %s\n", user.Username, role)
+	return nil
+}
+
+// Parses durations like 30m, 12h or 7d. "permanent" means the sanction never expires, and is returned as 0.
+func sanctionUser(kind server.SanctionKind) func(*server.DbTx, []string) error {
+	return func(dbTx *server.DbTx, args []string) error {
+		if len(args) < 3 {
+			return errUsage
+		}
+
+		user, err := getUser(dbTx, args[0])
+		if err != nil {
+			return err
+		}
+
+		duration, err := server.ParseSanctionDuration(args[1])
+		if err != nil {
+			return err
+		}
+
+		reason := strings.Join(args[2:], " ")
+		sanction, err := server.IssueUserSanction(dbTx, server.AdminCliActor, user.ID, user.Username, kind, reason, duration)
+		if err != nil {
+			return fmt.Errorf("error issuing %s: %w", kind, err)
+		}
+
+		fmt.Printf("%s: %s\n", user.Username, sanction.Describe(kind.PastTense()))
+		return nil
+	}
+}
+
+func revokeUserSanctions(kind server.SanctionKind) func(*server.DbTx, []string) error {
+	return func(dbTx *server.DbTx, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+
+		user, err := getUser(dbTx, args[0])
+		if err != nil {
+			return err
+		}
+
+		revoked, err := server.RevokeUserSanctions(dbTx, server.AdminCliActor, user.ID, user.Username, kind)
+		if err != nil {
+			return fmt.Errorf("error lifting %s: %w", kind, err)
+		}
+
+		fmt.Printf("Lifted %d %s(s) from %s\n", revoked, kind, user.Username)
+		return nil
+	}
+}
+
+func unbanUser(dbTx *server.DbTx, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	user, err := getUser(dbTx, args[0])
+	if err != nil {
+		return err
+	}
+
+	revoked, ipRevoked, err := server.RevokeUserBans(dbTx, server.AdminCliActor, user.ID, user.Username)
+	if err != nil {
+		return fmt.Errorf("error lifting bans: %w", err)
+	}
+
+	fmt.Printf("Lifted %d ban(s) from %s, and %d from the IP address they last logged in from\n", revoked, user.Username, ipRevoked)
+	return nil
+}
+
+func banIp(dbTx *server.DbTx, args []string) error {
+	if len(args) < 3 {
+		return errUsage
+	}
+
+	duration, err := server.ParseSanctionDuration(args[1])
+	if err != nil {
+		return err
+	}
+
+	reason := strings.Join(args[2:], " ")
+	ban, err := server.IssueIpBan(dbTx, server.AdminCliActor, args[0], reason, duration)
+	if err != nil {
+		return fmt.Errorf("error banning IP address: %w", err)
+	}
+
+	fmt.Printf("%s: %s\n", args[0], ban.Describe("banned"))
+	return nil
+}
+
+func unbanIp(dbTx *server.DbTx, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	revoked, err := server.RevokeIpBans(dbTx, server.AdminCliActor, args[0])
+	if err != nil {
+		return fmt.Errorf("error lifting IP bans: %w", err)
+	}
+
+	fmt.Printf("Lifted %d ban(s) from %s\n", revoked, args[0])
+	return nil
+}
+
+func showModerationLog(dbTx *server.DbTx, args []string) error {
+	if len(args) > 1 {
+		return errUsage
+	}
+
+	limit := int64(20)
+	if len(args) == 1 {
+		n, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid limit %q", args[0])
+		}
+		limit = n
+	}
+
+	entries, err := dbTx.Queries.GetModerationLog(dbTx.Ctx, limit)
+	if err != nil {
+		return fmt.Errorf("error getting moderation log: %w", err)
+	}
+
+	for _, entry := range entries {
+		createdAt := time.Unix(entry.CreatedAt, 0).UTC().Format(time.DateTime)
+		fmt.Printf("%s  %-20s %-10s %-20s %s\n", createdAt, entry.Actor, entry.Action, entry.Target, entry.Details)
+	}
+	return nil
+}
+
+func showChatLog(dbTx *server.DbTx, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errUsage
+	}
+
+	limit := int64(20)
+	if len(args) == 2 {
+		n, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid limit %q", args[1])
+		}
+		limit = n
+	}
+
+	user, err := getUser(dbTx, args[0])
+	if err != nil {
+		return err
+	}
+
+	player, err := dbTx.Queries.GetPlayerByUserId(dbTx.Ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("error getting player: %w", err)
+	}
+
+	messages, err := dbTx.Queries.SearchChatMessagesByPlayer(dbTx.Ctx, db.SearchChatMessagesByPlayerParams{
+		SenderPlayerID: player.ID,
+		SentAt:         0,
+		SentAt_2:       math.MaxInt64,
+		Limit:          limit,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting chat messages: %w", err)
+	}
+
+	for i := len(messages) - 1; i >= 0; i-- {
+		message := messages[i]
+		sentAt := time.Unix(message.SentAt, 0).UTC().Format(time.DateTime)
+		channel := packets.ChatChannel(message.Channel).String()
+		fmt.Printf("%s  %-20s %-22s %-20s %s\n", sentAt, message.SenderName, channel, message.Target, message.Msg)
+	}
+	return nil
+}
+
+func listReports(dbTx *server.DbTx, args []string) error {
+	if len(args) > 2 {
+		return errUsage
+	}
+
+	showAll := false
+	if len(args) > 0 {
+		switch args[0] {
+		case "open":
+		case "all":
+			showAll = true
+		default:
+			return errUsage
+		}
+	}
+
+	limit := int64(20)
+	if len(args) == 2 {
+		n, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid limit %q", args[1])
+		}
+		limit = n
+	}
+
+	var reports []db.PlayerReport
+	var err error
+	if showAll {
+		reports, err = dbTx.Queries.GetPlayerReports(dbTx.Ctx, limit)
+	} else {
+		reports, err = dbTx.Queries.GetOpenPlayerReports(dbTx.Ctx, limit)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting reports: %w", err)
+	}
+
+	for _, report := range reports {
+		status := "open"
+		if report.ResolvedAt.Valid {
+			status = "resolved"
+		}
+		createdAt := time.Unix(report.CreatedAt, 0).UTC().Format(time.DateTime)
+		fmt.Printf("#%-6d %s  %-8s %-15s %-20s -> %-20s %s\n", report.ID, createdAt, status, reportCategoryName(report.Category), report.ReporterName, report.ReportedName, report.Details)
+	}
+	return nil
+}
+
+func showReport(dbTx *server.DbTx, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	report, err := getReport(dbTx, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Report #%d\n", report.ID)
+	fmt.Printf("Filed:    %s\n", time.Unix(report.CreatedAt, 0).UTC().Format(time.DateTime))
+	fmt.Printf("Reporter: %s\n", report.ReporterName)
+	fmt.Printf("Reported: %s\n", report.ReportedName)
+	fmt.Printf("Category: %s\n", reportCategoryName(report.Category))
+	fmt.Printf("Details:  %s\n", report.Details)
+	if report.ResolvedAt.Valid {
+		resolvedAt := time.Unix(report.ResolvedAt.Int64, 0).UTC().Format(time.DateTime)
+		fmt.Printf("Resolved: %s by %s: %s\n", resolvedAt, report.ResolvedBy, report.Resolution)
+	}
+
+	var context bytes.Buffer
+	if err := json.Indent(&context, []byte(report.Context), "", "  "); err != nil {
+		return fmt.Errorf("error formatting report context: %w", err)
+	}
+	fmt.Printf("Context:\n%s\n", context.String())
+	return nil
+}
+
+func resolveReport(dbTx *server.DbTx, args []string) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+
+	report, err := getReport(dbTx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := server.ResolvePlayerReport(dbTx, server.AdminCliActor, report, strings.Join(args[1:], " ")); err != nil {
+		return fmt.Errorf("error resolving report: %w", err)
+	}
+
+	fmt.Printf("Resolved report #%d against %s\n", report.ID, report.ReportedName)
+	return nil
+}
+
+func getReport(dbTx *server.DbTx, id string) (db.PlayerReport, error) {
+	reportId, err := strconv.ParseInt(strings.TrimPrefix(id, "#"), 10, 64)
+	if err != nil {
+		return db.PlayerReport{}, fmt.Errorf("invalid report ID %q", id)
+	}
+
+	report, err := dbTx.Queries.GetPlayerReport(dbTx.Ctx, reportId)
+	if err != nil {
+		return db.PlayerReport{}, fmt.Errorf("error getting report %d: %w", reportId, err)
+	}
+	return report, nil
+}
+
+// e.g. "offensive_name" for REPORT_CATEGORY_OFFENSIVE_NAME
+func reportCategoryName(category int64) string {
+	return strings.ToLower(strings.TrimPrefix(packets.ReportCategory(category).String(), "REPORT_CATEGORY_"))
+}
+
+func startSeason(dbTx *server.DbTx, args []string) error {
+	if len(args) < 1 {
+		return errUsage
+	}
+
+	season, err := server.StartSeason(dbTx, strings.Join(args, " "))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Started season %s\n", season.Name)
+	return nil
+}
+
+func listSeasons(dbTx *server.DbTx, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	seasons, err := dbTx.Queries.GetSeasons(dbTx.Ctx)
+	if err != nil {
+		return fmt.Errorf("error getting seasons: %w", err)
+	}
+
+	for _, season := range seasons {
+		startedAt := time.Unix(season.StartedAt, 0).UTC().Format(time.DateTime)
+		endedAt := "ongoing"
+		if season.EndedAt.Valid {
+			endedAt = time.Unix(season.EndedAt.Int64, 0).UTC().Format(time.DateTime)
+		}
+		fmt.Printf("%-20s %s  %s\n", season.Name, startedAt, endedAt)
+	}
+	return nil
+}
@@ -4,11 +4,15 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
 	"server/internal/server"
 	"server/internal/server/clients"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
+	"server/internal/server/tracing"
 	"strconv"
 	"strings"
 
@@ -22,25 +26,136 @@ const (
 )
 
 type config struct {
-	Port       int
-	DataPath   string
-	CertPath   string
-	KeyPath    string
-	ClientPath string
+	Port                 int
+	DataPath             string
+	CertPath             string
+	KeyPath              string
+	ClientPath           string
+	DuplicateLoginPolicy server.DuplicateLoginPolicy
+	ChatFilter           server.ChatFilterConfig
+	AchievementsPath     string
+	AdminSocketPath      string
+	AdminApiToken        string
+	AdminAddr            string
+	LogLevel             slog.Level
+	LogJson              bool
+	LogLevels            map[string]slog.Level
+	TraceExporter        string
+	TraceFile            string
+	TraceOtlpEndpoint    string
+	TraceSampling        tracing.Sampling
 }
 
 var (
-	defaultConfig = &config{Port: 8080}
-	configPath    = flag.String("config", ".env", "Path to the config file")
+	defaultConfig = &config{
+		Port:                 8080,
+		DuplicateLoginPolicy: server.RejectDuplicateLogin,
+		ChatFilter:           server.DefaultChatFilterConfig,
+		TraceOtlpEndpoint:    "http://localhost:4318/v1/traces",
+		TraceSampling:        tracing.Sampling{Rate: 0.01},
+	}
+	configPath = flag.String("config", ".env", "Path to the config file")
+	console    = flag.Bool("console", false, "Read admin console commands from stdin")
 )
 
 func loadConfig() *config {
-	cfg := defaultConfig
+	// Copy the defaults, so reloading the config doesn't pick up anything left over from the last time it was loaded
+	defaults := *defaultConfig
+	cfg := &defaults
 	cfg.DataPath = os.Getenv("DATA_PATH")
 	cfg.CertPath = os.Getenv("CERT_PATH")
 	cfg.KeyPath = os.Getenv("KEY_PATH")
 	cfg.ClientPath = os.Getenv("CLIENT_PATH")
 
+	// A JSON file of achievement definitions to use instead of the defaults
+	cfg.AchievementsPath = os.Getenv("ACHIEVEMENTS_PATH")
+
+	// Where to listen for admin console connections, if anywhere
+	cfg.AdminSocketPath = os.Getenv("ADMIN_SOCKET_PATH")
+
+	// Lets scripts use the admin API without an admin account. Admin accounts can use it either way.
+	cfg.AdminApiToken = os.Getenv("ADMIN_API_TOKEN")
+
+	// Where to serve the admin API and metrics, e.g. 127.0.0.1:8081. They're kept off the game's port so they can be
+	// firewalled off.
+	cfg.AdminAddr = os.Getenv("ADMIN_ADDR")
+
+	if logLevel := os.Getenv("LOG_LEVEL"); logLevel != "" {
+		if level, err := logging.ParseLevel(logLevel); err != nil {
+			log.Printf("Error parsing LOG_LEVEL, using %s", cfg.LogLevel)
+		} else {
+			cfg.LogLevel = level
+		}
+	}
+
+	switch format := os.Getenv("LOG_FORMAT"); strings.ToLower(format) {
+	case "json":
+		cfg.LogJson = true
+	case "text", "":
+		cfg.LogJson = false
+	default:
+		log.Printf("Unknown LOG_FORMAT %s, using text", format)
+	}
+
+	// Levels for particular subsystems, e.g. states=debug,hub=warn
+	if logLevels := os.Getenv("LOG_LEVELS"); logLevels != "" {
+		if levels, err := logging.ParseSubsystemLevels(logLevels); err != nil {
+			log.Printf("Error parsing LOG_LEVELS, ignoring them: %v", err)
+		} else {
+			cfg.LogLevels = levels
+		}
+	}
+
+	// Where to send traces of packets being handled, if anywhere: file or otlp
+	cfg.TraceExporter = strings.ToLower(os.Getenv("TRACE_EXPORTER"))
+	cfg.TraceFile = os.Getenv("TRACE_FILE")
+	if endpoint := os.Getenv("TRACE_OTLP_ENDPOINT"); endpoint != "" {
+		cfg.TraceOtlpEndpoint = endpoint
+	}
+
+	if sampleRate := os.Getenv("TRACE_SAMPLE_RATE"); sampleRate != "" {
+		if rate, err := strconv.ParseFloat(sampleRate, 64); err != nil || rate < 0 || rate > 1 {
+			log.Printf("Error parsing TRACE_SAMPLE_RATE, using %g", cfg.TraceSampling.Rate)
+		} else {
+			cfg.TraceSampling.Rate = rate
+		}
+	}
+
+	// Message types to trace every time, e.g. SporeConsumed,PlayerConsumed
+	if messageTypes := os.Getenv("TRACE_MESSAGES"); messageTypes != "" {
+		cfg.TraceSampling.Always = strings.Split(messageTypes, ",")
+	}
+
+	if policy := os.Getenv("DUPLICATE_LOGIN_POLICY"); policy != "" {
+		if parsed, err := server.ParseDuplicateLoginPolicy(policy); err != nil {
+			log.Printf("Unknown DUPLICATE_LOGIN_POLICY %s, using reject", policy)
+			cfg.DuplicateLoginPolicy = server.RejectDuplicateLogin
+		} else {
+			cfg.DuplicateLoginPolicy = parsed
+		}
+	}
+
+	if maxLength := os.Getenv("CHAT_MAX_LENGTH"); maxLength != "" {
+		if length, err := strconv.Atoi(maxLength); err != nil || length <= 0 {
+			log.Printf("Error parsing CHAT_MAX_LENGTH, using %d", cfg.ChatFilter.MaxLength)
+		} else {
+			cfg.ChatFilter.MaxLength = length
+		}
+	}
+
+	if burstSize := os.Getenv("CHAT_BURST_SIZE"); burstSize != "" {
+		if size, err := strconv.Atoi(burstSize); err != nil || size <= 0 {
+			log.Printf("Error parsing CHAT_BURST_SIZE, using %d", cfg.ChatFilter.BurstSize)
+		} else {
+			cfg.ChatFilter.BurstSize = size
+		}
+	}
+
+	// A comma-separated list of words to mask out of chat messages
+	if bannedWords := os.Getenv("CHAT_BANNED_WORDS"); bannedWords != "" {
+		cfg.ChatFilter.BannedWords = strings.Split(bannedWords, ",")
+	}
+
 	port, err := strconv.Atoi(os.Getenv("PORT"))
 	if err != nil {
 		log.Printf("Error parsing PORT, using %d", cfg.Port)
@@ -94,12 +209,61 @@ func main() {
 		cfg = loadConfig()
 	}
 
+	logging.Setup(os.Stderr, cfg.LogLevel, cfg.LogJson)
+	applyLogLevels(cfg.LogLevels)
+
 	// Try to load the Docker-mounted data directory. If that fails, fall back
 	// to the current directory
 	cfg.DataPath = coalescePaths(cfg.DataPath, dockerMountedDataDir, ".")
 
+	setupTracing(cfg)
+
 	// Define the game hub
 	hub := server.NewHub(cfg.DataPath)
+	hub.DuplicateLoginPolicy = cfg.DuplicateLoginPolicy
+	hub.ChatFilter = server.NewChatFilter(cfg.ChatFilter)
+
+	if cfg.AchievementsPath != "" {
+		achievements, err := server.LoadAchievements(cfg.AchievementsPath)
+		if err != nil {
+			log.Fatalf("Error loading achievements from %s: %v", cfg.AchievementsPath, err)
+		}
+		log.Printf("Loaded %d achievements from %s", len(achievements.All()), cfg.AchievementsPath)
+		hub.Achievements = achievements
+	}
+
+	// Set up the admin console, which can reload the parts of the config that can change while the server is running
+	adminConsole := server.NewConsole(hub, func() (any, error) {
+		if err := godotenv.Overload(*configPath); err != nil {
+			return nil, fmt.Errorf("error loading config file: %w", err)
+		}
+		reloaded := loadConfig()
+		hub.Reconfigure(reloaded.DuplicateLoginPolicy, reloaded.ChatFilter)
+		logging.SetLevel("default", reloaded.LogLevel)
+		applyLogLevels(reloaded.LogLevels)
+		if err := tracing.SetSampling(reloaded.TraceSampling); err != nil {
+			log.Printf("Error applying trace sampling: %v", err)
+		}
+		log.Printf("Reloaded config from %s", *configPath)
+
+		return map[string]any{
+			"config_path":            *configPath,
+			"duplicate_login_policy": reloaded.DuplicateLoginPolicy.String(),
+			"chat_max_length":        reloaded.ChatFilter.MaxLength,
+			"chat_burst_size":        reloaded.ChatFilter.BurstSize,
+			"chat_banned_words":      len(reloaded.ChatFilter.BannedWords),
+			"log_levels":             logging.Levels(),
+			"trace_sampling":         tracing.CurrentSampling(),
+		}, nil
+	})
+	if cfg.AdminSocketPath != "" {
+		if err := adminConsole.ListenUnix(cfg.AdminSocketPath); err != nil {
+			log.Fatalf("Error starting admin console on %s: %v", cfg.AdminSocketPath, err)
+		}
+	}
+	if *console {
+		go adminConsole.Serve(os.Stdin, os.Stdout, true)
+	}
 
 	// Define handler for serving the HTML5 export
 	exportPath := coalescePaths(cfg.ClientPath, filepath.Join(cfg.DataPath, "html5"))
@@ -112,6 +276,10 @@ func main() {
 		http.Handle("/", addHeaders(http.StripPrefix("/", http.FileServer(http.Dir(exportPath)))))
 	}
 
+	// Define handlers for container orchestration to check on the server
+	http.HandleFunc("/healthz", hub.ServeHealthz)
+	http.HandleFunc("/readyz", hub.ServeReadyz)
+
 	// Define handler for WebSocket connections
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		hub.Serve(clients.NewWebSocketClient, w, r)
@@ -120,11 +288,14 @@ func main() {
 	go hub.Run()
 	addr := fmt.Sprintf(":%d", cfg.Port)
 
-	log.Printf("Starting server on %s", addr)
-
 	cfg.CertPath = resolveLiveCertsPath(cfg.CertPath)
 	cfg.KeyPath = resolveLiveCertsPath(cfg.KeyPath)
 
+	if cfg.AdminAddr != "" {
+		go serveAdmin(cfg, hub)
+	}
+
+	log.Printf("Starting server on %s", addr)
 	log.Printf("Using cert at %s and key at %s", cfg.CertPath, cfg.KeyPath)
 
 	err = http.ListenAndServeTLS(addr, cfg.CertPath, cfg.KeyPath, nil)
@@ -139,6 +310,79 @@ func main() {
 	}
 }
 
+// Serves metrics for Prometheus to scrape and the admin API on their own address, over TLS if there's a certificate to
+// use. Admins can only sign in with their password over TLS, so without it the API is only served if there's a token
+// to use instead.
+func serveAdmin(cfg *config, hub *server.Hub) {
+	_, certErr := os.Stat(cfg.CertPath)
+	_, keyErr := os.Stat(cfg.KeyPath)
+	useTls := cfg.CertPath != "" && cfg.KeyPath != "" && certErr == nil && keyErr == nil
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	served := "metrics"
+	if useTls || cfg.AdminApiToken != "" {
+		mux.Handle("/admin/api/", server.NewAdminApi(hub, cfg.AdminApiToken))
+		served = "metrics and the admin API"
+	} else {
+		log.Printf("Not serving the admin API on %s, since there's no certificate for TLS and no ADMIN_API_TOKEN", cfg.AdminAddr)
+	}
+
+	var err error
+	if useTls {
+		log.Printf("Serving %s on %s", served, cfg.AdminAddr)
+		err = http.ListenAndServeTLS(cfg.AdminAddr, cfg.CertPath, cfg.KeyPath, mux)
+	} else {
+		log.Printf("Serving %s on %s without TLS", served, cfg.AdminAddr)
+		err = http.ListenAndServe(cfg.AdminAddr, mux)
+	}
+	log.Fatalf("Error serving on %s: %v", cfg.AdminAddr, err)
+}
+
+// Sets the levels of particular subsystems, creating any which haven't logged anything yet
+func applyLogLevels(levels map[string]slog.Level) {
+	for name, level := range levels {
+		logging.Subsystem(name)
+		if err := logging.SetLevel(name, level); err != nil {
+			log.Printf("Error setting log level of %s: %v", name, err)
+		}
+	}
+}
+
+// Starts exporting traces of packets being handled, if the config asks for it
+func setupTracing(cfg *config) {
+	var exporter tracing.Exporter
+	switch cfg.TraceExporter {
+	case "":
+		return
+	case "file":
+		path := cfg.TraceFile
+		if path == "" {
+			path = filepath.Join(cfg.DataPath, "traces.jsonl")
+		}
+		fileExporter, err := tracing.NewFileExporter(path)
+		if err != nil {
+			log.Printf("Error opening trace file, not tracing: %v", err)
+			return
+		}
+		exporter = fileExporter
+	case "otlp":
+		otlpExporter, err := tracing.NewOtlpExporter(cfg.TraceOtlpEndpoint)
+		if err != nil {
+			log.Printf("Error with TRACE_OTLP_ENDPOINT, not tracing: %v", err)
+			return
+		}
+		exporter = otlpExporter
+	default:
+		log.Printf("Unknown TRACE_EXPORTER %s, not tracing", cfg.TraceExporter)
+		return
+	}
+
+	if err := tracing.Setup(exporter, cfg.TraceSampling); err != nil {
+		log.Printf("Error setting up tracing: %v", err)
+	}
+}
+
 // Add headers required for the HTML5 export to work with threads
 func addHeaders(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// What an achievement measures
+type AchievementMetric string
+
+const (
+	// The player's mass
+	AchievementMetricMass AchievementMetric = "mass"
+
+	// How many spores the player has eaten in one life
+	AchievementMetricSporesEatenInLife AchievementMetric = "spores_eaten_in_life"
+
+	// How many players the player has eaten in one life
+	AchievementMetricPlayersEatenInLife AchievementMetric = "players_eaten_in_life"
+
+	// How long the player has survived in one life, in seconds
+	AchievementMetricSecondsAlive AchievementMetric = "seconds_alive"
+)
+
+// Something a player can earn in the game, unlocked once its metric reaches the threshold
+type Achievement struct {
+	Id          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Metric      AchievementMetric `json:"metric"`
+	Threshold   int64             `json:"threshold"`
+}
+
+// The achievements used unless the server is given a file of its own
+var DefaultAchievements = []Achievement{
+	{Id: "first_bite", Name: "First Bite", Description: "Eat another player", Metric: AchievementMetricPlayersEatenInLife, Threshold: 1},
+	{Id: "apex_predator", Name: "Apex Predator", Description: "Eat 5 players in one life", Metric: AchievementMetricPlayersEatenInLife, Threshold: 5},
+	{Id: "grazer", Name: "Grazer", Description: "Eat 100 spores in one life", Metric: AchievementMetricSporesEatenInLife, Threshold: 100},
+	{Id: "heavyweight", Name: "Heavyweight", Description: "Reach a mass of 20,000", Metric: AchievementMetricMass, Threshold: 20_000},
+	{Id: "colossus", Name: "Colossus", Description: "Reach a mass of 100,000", Metric: AchievementMetricMass, Threshold: 100_000},
+	{Id: "survivor", Name: "Survivor", Description: "Survive for 10 minutes", Metric: AchievementMetricSecondsAlive, Threshold: 10 * 60},
+	{Id: "immortal", Name: "Immortal", Description: "Survive for an hour", Metric: AchievementMetricSecondsAlive, Threshold: 60 * 60},
+}
+
+// The achievements players can earn, in the order they're listed
+type Achievements struct {
+	all  []Achievement
+	byId map[string]Achievement
+}
+
+// Checks the definitions make sense before anyone can earn them
+func NewAchievements(definitions []Achievement) (*Achievements, error) {
+	achievements := &Achievements{
+		all:  make([]Achievement, 0, len(definitions)),
+		byId: make(map[string]Achievement, len(definitions)),
+	}
+
+	for _, achievement := range definitions {
+		if achievement.Id == "" {
+			return nil, fmt.Errorf("achievement %q has no id", achievement.Name)
+		}
+		if _, exists := achievements.byId[achievement.Id]; exists {
+			return nil, fmt.Errorf("achievement id %s is used more than once", achievement.Id)
+		}
+
+		switch achievement.Metric {
+		case AchievementMetricMass, AchievementMetricSporesEatenInLife, AchievementMetricPlayersEatenInLife, AchievementMetricSecondsAlive:
+		default:
+			return nil, fmt.Errorf("achievement %s has unknown metric %q", achievement.Id, achievement.Metric)
+		}
+
+		if achievement.Threshold <= 0 {
+			return nil, fmt.Errorf("achievement %s must have a positive threshold", achievement.Id)
+		}
+
+		achievements.all = append(achievements.all, achievement)
+		achievements.byId[achievement.Id] = achievement
+	}
+
+	return achievements, nil
+}
+
+// Reads achievement definitions from a JSON file holding a list of them
+func LoadAchievements(path string) (*Achievements, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []Achievement
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("error parsing achievements: %w", err)
+	}
+
+	return NewAchievements(definitions)
+}
+
+func (a *Achievements) All() []Achievement {
+	return a.all
+}
+
+func (a *Achievements) Get(id string) (Achievement, bool) {
+	achievement, exists := a.byId[id]
+	return achievement, exists
+}
+
+// Starts tracking a player's progress towards the achievements they haven't unlocked yet
+func (a *Achievements) NewTracker(unlockedIds []string) *AchievementTracker {
+	unlocked := make(map[string]bool, len(unlockedIds))
+	for _, id := range unlockedIds {
+		unlocked[id] = true
+	}
+
+	return &AchievementTracker{
+		achievements: a,
+		unlocked:     unlocked,
+		progress:     make(map[AchievementMetric]int64),
+	}
+}
+
+// Works out which achievements a player earns as they play, from the same events that make up their stats
+type AchievementTracker struct {
+	achievements  *Achievements
+	unlocked      map[string]bool
+	progress      map[AchievementMetric]int64
+	lifeStartedAt time.Time
+	mux           sync.Mutex
+}
+
+// Counts the event towards the player's progress, returning any achievements it has just unlocked
+func (t *AchievementTracker) Observe(event StatsEvent) []Achievement {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	switch event.Kind {
+	case StatsLifeStarted:
+		clear(t.progress)
+		t.lifeStartedAt = event.At
+		return nil
+	case StatsLifeEnded:
+		t.lifeStartedAt = time.Time{}
+		return nil
+	case StatsSporeEaten:
+		t.progress[AchievementMetricSporesEatenInLife]++
+		return t.check(AchievementMetricSporesEatenInLife)
+	case StatsPlayerEaten:
+		t.progress[AchievementMetricPlayersEatenInLife]++
+		return t.check(AchievementMetricPlayersEatenInLife)
+	case StatsMassReached:
+		t.progress[AchievementMetricMass] = max(t.progress[AchievementMetricMass], int64(event.Amount))
+		return t.check(AchievementMetricMass)
+	default:
+		return nil
+	}
+}
+
+// Counts the time the player has been alive for, returning any achievements surviving this long has just unlocked
+func (t *AchievementTracker) Tick(now time.Time) []Achievement {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if t.lifeStartedAt.IsZero() {
+		return nil
+	}
+
+	t.progress[AchievementMetricSecondsAlive] = int64(now.Sub(t.lifeStartedAt).Seconds())
+	return t.check(AchievementMetricSecondsAlive)
+}
+
+// Unlocks any achievements for the metric whose threshold the player has reached. The caller must hold the lock.
+func (t *AchievementTracker) check(metric AchievementMetric) []Achievement {
+	var unlocked []Achievement
+	for _, achievement := range t.achievements.all {
+		if achievement.Metric != metric || t.unlocked[achievement.Id] || t.progress[metric] < achievement.Threshold {
+			continue
+		}
+		t.unlocked[achievement.Id] = true
+		unlocked = append(unlocked, achievement)
+	}
+	return unlocked
+}
@@ -0,0 +1,592 @@
+package server
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"server/internal/server/db"
+	"server/internal/server/logging"
+	"server/internal/server/objects"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The actor recorded in the moderation log for actions taken with the admin API token. Actions taken by admins
+// signing in with their own account are recorded against their username instead.
+const AdminApiActor = "admin API"
+
+// The largest request body the admin API will read
+const maxAdminApiBodySize = 1 << 16
+
+const (
+	// How many times in a row an IP address can fail to authenticate before it's locked out
+	maxAdminLoginFailures = 5
+
+	// How long an IP address is locked out for after failing to authenticate too many times
+	adminLoginLockout = 15 * time.Minute
+)
+
+// Returned by authenticate when the request didn't try to authenticate at all, which doesn't count as a failure
+var errNoCredentials = errors.New("no credentials")
+
+// An error which should be sent back with a particular HTTP status, rather than as an internal server error
+type adminApiError struct {
+	status  int
+	message string
+}
+
+func (e *adminApiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...any) error {
+	return &adminApiError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...any) error {
+	return &adminApiError{status: http.StatusNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+// Handles an authenticated admin API request, returning what to send back as JSON. The actor is who made the request,
+// for the moderation log.
+type adminApiHandler func(request *http.Request, actor string) (any, error)
+
+// Lets admins look after the running server over HTTP. Every request needs either the admin token, as a bearer token,
+// or the username and password of an account with the admin role, using basic auth over TLS. IP addresses which fail
+// to authenticate too many times in a row are locked out for a while.
+type AdminApi struct {
+	hub      *Hub
+	token    string
+	mux      *http.ServeMux
+	failures *loginFailures
+}
+
+// Sets up the API to be served under /admin/api/. If the token is empty, only admin accounts can use it.
+func NewAdminApi(hub *Hub, token string) *AdminApi {
+	api := &AdminApi{hub: hub, token: token, mux: http.NewServeMux(), failures: newLoginFailures()}
+
+	api.handle("GET /admin/api/players", api.getPlayers)
+	api.handle("GET /admin/api/accounts/{username}", api.getAccount)
+	api.handle("POST /admin/api/bans", api.createBan)
+	api.handle("DELETE /admin/api/bans/{username}", api.deleteBans)
+	api.handle("PUT /admin/api/hiscores/{name}", api.correctHiscore)
+	api.handle("GET /admin/api/stats", api.getStats)
+	api.handle("GET /admin/api/rules", api.getRules)
+	api.handle("PATCH /admin/api/rules", api.updateRules)
+	api.handle("GET /admin/api/log-levels", api.getLogLevels)
+	api.handle("PUT /admin/api/log-levels/{subsystem}", api.setLogLevel)
+
+	return api
+}
+
+func (a *AdminApi) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	a.mux.ServeHTTP(writer, request)
+}
+
+func (a *AdminApi) handle(pattern string, handler adminApiHandler) {
+	a.mux.HandleFunc(pattern, func(writer http.ResponseWriter, request *http.Request) {
+		ipAddress, _, err := net.SplitHostPort(request.RemoteAddr)
+		if err != nil {
+			ipAddress = request.RemoteAddr
+		}
+
+		if lockedFor := a.failures.lockedOut(ipAddress, time.Now()); lockedFor > 0 {
+			adminLogger.Warnf("Admin API: refused %s %s from %s: locked out after too many failed logins", request.Method, request.URL.Path, ipAddress)
+			writer.Header().Set("Retry-After", strconv.Itoa(int(lockedFor.Seconds())+1))
+			writeJson(writer, http.StatusTooManyRequests, map[string]string{"error": "too many failed logins, try again later"})
+			return
+		}
+
+		actor, err := a.authenticate(request)
+		if err != nil {
+			if !errors.Is(err, errNoCredentials) {
+				a.failures.add(ipAddress, time.Now())
+			}
+			adminLogger.Warnf("Admin API: refused %s %s from %s: %v", request.Method, request.URL.Path, ipAddress, err)
+			writer.Header().Set("WWW-Authenticate", `Basic realm="admin"`)
+			writeJson(writer, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+			return
+		}
+		a.failures.clear(ipAddress)
+
+		adminLogger.Printf("Admin API: %s %s by %s", request.Method, request.URL.Path, actor)
+		result, err := handler(request, actor)
+
+		var apiErr *adminApiError
+		switch {
+		case errors.As(err, &apiErr):
+			writeJson(writer, apiErr.status, map[string]string{"error": apiErr.message})
+		case err != nil:
+			adminLogger.Errorf("Admin API: error handling %s %s: %v", request.Method, request.URL.Path, err)
+			writeJson(writer, http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+		default:
+			writeJson(writer, http.StatusOK, result)
+		}
+	})
+}
+
+// Works out who's making the request, returning the name to record them as in the moderation log
+func (a *AdminApi) authenticate(request *http.Request) (string, error) {
+	if token, found := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer "); found {
+		if a.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+			return "", errors.New("wrong token")
+		}
+		return AdminApiActor, nil
+	}
+
+	username, password, ok := request.BasicAuth()
+	if !ok {
+		return "", errNoCredentials
+	}
+
+	// Basic auth sends the password as it is, so it's only accepted over TLS
+	if request.TLS == nil {
+		return "", errors.New("basic auth is only accepted over TLS")
+	}
+
+	dbTx := a.hub.NewDbTx()
+	user, err := dbTx.Queries.GetUserByUsername(dbTx.Ctx, strings.ToLower(username))
+	if err != nil {
+		return "", fmt.Errorf("error getting user %s: %w", username, err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", fmt.Errorf("incorrect password for user %s", user.Username)
+	}
+
+	role, err := GetUserRole(dbTx, user.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting role of user %s: %w", user.Username, err)
+	}
+	if role != RoleAdmin {
+		return "", fmt.Errorf("user %s is a %s, not an admin", user.Username, role)
+	}
+	return user.Username, nil
+}
+
+// Counts how many times in a row each IP address has failed to authenticate
+type loginFailures struct {
+	mux      sync.Mutex
+	failures map[string]loginFailure
+}
+
+type loginFailure struct {
+	count  int
+	lastAt time.Time
+}
+
+func newLoginFailures() *loginFailures {
+	return &loginFailures{failures: make(map[string]loginFailure)}
+}
+
+// How much longer the IP address is locked out for, or 0 if it isn't
+func (l *loginFailures) lockedOut(ipAddress string, now time.Time) time.Duration {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	failure, exists := l.failures[ipAddress]
+	if !exists || failure.count < maxAdminLoginFailures {
+		return 0
+	}
+	return max(failure.lastAt.Add(adminLoginLockout).Sub(now), 0)
+}
+
+func (l *loginFailures) add(ipAddress string, now time.Time) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	// Failures are forgotten once the lockout they'd count towards would be over
+	for ip, failure := range l.failures {
+		if now.Sub(failure.lastAt) > adminLoginLockout {
+			delete(l.failures, ip)
+		}
+	}
+
+	failure := l.failures[ipAddress]
+	l.failures[ipAddress] = loginFailure{count: failure.count + 1, lastAt: now}
+}
+
+func (l *loginFailures) clear(ipAddress string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	delete(l.failures, ipAddress)
+}
+
+func writeJson(writer http.ResponseWriter, status int, body any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(body); err != nil {
+		adminLogger.Errorf("Admin API: error writing response: %v", err)
+	}
+}
+
+func readJson(request *http.Request, body any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, request.Body, maxAdminApiBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(body); err != nil {
+		return badRequest("invalid request body: %v", err)
+	}
+	return nil
+}
+
+func (a *AdminApi) getUser(dbTx *DbTx, username string) (db.User, error) {
+	user, err := dbTx.Queries.GetUserByUsername(dbTx.Ctx, strings.ToLower(username))
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.User{}, notFound("no user found with username %s", username)
+	}
+	return user, err
+}
+
+func (a *AdminApi) getPlayers(request *http.Request, actor string) (any, error) {
+	online := make([]ClientSummary, 0)
+	for _, client := range a.hub.ClientSummaries() {
+		if client.Player != nil {
+			online = append(online, client)
+		}
+	}
+	return online, nil
+}
+
+type adminSanction struct {
+	Reason    string `json:"reason"`
+	IssuedBy  string `json:"issued_by"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+}
+
+func newAdminSanction(sanction *Sanction) *adminSanction {
+	if sanction == nil {
+		return nil
+	}
+
+	result := &adminSanction{Reason: sanction.Reason, IssuedBy: sanction.IssuedBy}
+	if !sanction.ExpiresAt.IsZero() {
+		result.ExpiresAt = sanction.ExpiresAt.Unix()
+	}
+	return result
+}
+
+type adminAccount struct {
+	UserId          int64          `json:"user_id"`
+	Username        string         `json:"username"`
+	Role            Role           `json:"role"`
+	PlayerName      string         `json:"player_name"`
+	BestScore       int64          `json:"best_score"`
+	Level           int64          `json:"level"`
+	Xp              int64          `json:"xp"`
+	Online          bool           `json:"online"`
+	ClientId        uint64         `json:"client_id,omitempty"`
+	LastLoginIp     string         `json:"last_login_ip,omitempty"`
+	Ban             *adminSanction `json:"ban,omitempty"`
+	Mute            *adminSanction `json:"mute,omitempty"`
+	PendingDeletion bool           `json:"pending_deletion"`
+}
+
+func (a *AdminApi) getAccount(request *http.Request, actor string) (any, error) {
+	dbTx := a.hub.NewDbTx()
+	user, err := a.getUser(dbTx, request.PathValue("username"))
+	if err != nil {
+		return nil, err
+	}
+
+	account := adminAccount{UserId: user.ID, Username: user.Username}
+
+	if account.Role, err = GetUserRole(dbTx, user.ID); err != nil {
+		return nil, fmt.Errorf("error getting role: %w", err)
+	}
+
+	player, err := dbTx.Queries.GetPlayerByUserId(dbTx.Ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting player: %w", err)
+	}
+	account.PlayerName = player.Name
+	account.BestScore = player.BestScore
+
+	progress, err := GetPlayerProgress(dbTx, player.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting progress: %w", err)
+	}
+	account.Xp = progress.Xp
+	account.Level = LevelForXp(progress.Xp)
+
+	account.ClientId, account.Online = a.hub.LoggedInAccounts.ClientId(user.ID)
+
+	lastLoginIp, err := dbTx.Queries.GetLastLoginIp(dbTx.Ctx, user.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error getting last login IP address: %w", err)
+	}
+	account.LastLoginIp = lastLoginIp
+
+	ban, err := GetActiveUserSanction(dbTx, user.ID, SanctionBan)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ban: %w", err)
+	}
+	account.Ban = newAdminSanction(ban)
+
+	mute, err := GetActiveUserSanction(dbTx, user.ID, SanctionMute)
+	if err != nil {
+		return nil, fmt.Errorf("error getting mute: %w", err)
+	}
+	account.Mute = newAdminSanction(mute)
+
+	_, err = dbTx.Queries.GetAccountDeletion(dbTx.Ctx, user.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error getting account deletion: %w", err)
+	}
+	account.PendingDeletion = err == nil
+
+	return account, nil
+}
+
+type adminBanRequest struct {
+	Username string `json:"username"`
+
+	// e.g. 30m, 12h or 7d, or "permanent"
+	Duration string `json:"duration"`
+	Reason   string `json:"reason"`
+
+	// Whether to ban the IP address the user last logged in from as well
+	BanIp bool `json:"ban_ip"`
+}
+
+// Bans a user, kicking them if they're online
+func (a *AdminApi) createBan(request *http.Request, actor string) (any, error) {
+	var body adminBanRequest
+	if err := readJson(request, &body); err != nil {
+		return nil, err
+	}
+	if body.Username == "" || body.Reason == "" {
+		return nil, badRequest("username and reason are required")
+	}
+
+	duration, err := ParseSanctionDuration(body.Duration)
+	if err != nil {
+		return nil, badRequest("%v", err)
+	}
+
+	dbTx := a.hub.NewDbTx()
+	user, err := a.getUser(dbTx, body.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	ban, err := IssueUserSanction(dbTx, actor, user.ID, user.Username, SanctionBan, body.Reason, duration)
+	if err != nil {
+		return nil, fmt.Errorf("error banning user %s: %w", user.Username, err)
+	}
+
+	if body.BanIp {
+		ipAddress, err := dbTx.Queries.GetLastLoginIp(dbTx.Ctx, user.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, notFound("user %s has no known IP address to ban", user.Username)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting last login IP address of user %s: %w", user.Username, err)
+		}
+		if _, err := IssueIpBan(dbTx, actor, ipAddress, body.Reason, duration); err != nil {
+			return nil, fmt.Errorf("error banning IP address of user %s: %w", user.Username, err)
+		}
+	}
+
+	if clientId, online := a.hub.LoggedInAccounts.ClientId(user.ID); online {
+		if client, exists := a.hub.Clients.Get(clientId); exists {
+			client.Kick(ban.Describe(SanctionBan.PastTense()))
+		}
+	}
+
+	return map[string]any{"username": user.Username, "ban": newAdminSanction(ban)}, nil
+}
+
+// Lifts every ban on a user, and on the IP address they last logged in from
+func (a *AdminApi) deleteBans(request *http.Request, actor string) (any, error) {
+	dbTx := a.hub.NewDbTx()
+	user, err := a.getUser(dbTx, request.PathValue("username"))
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, ipRevoked, err := RevokeUserBans(dbTx, actor, user.ID, user.Username)
+	if err != nil {
+		return nil, fmt.Errorf("error lifting bans on user %s: %w", user.Username, err)
+	}
+	return map[string]any{"username": user.Username, "revoked": revoked, "ip_revoked": ipRevoked}, nil
+}
+
+type adminHiscoreCorrection struct {
+	BestScore int64  `json:"best_score"`
+	Reason    string `json:"reason"`
+}
+
+// Lowers or raises a player's best score, e.g. after they were caught cheating. Scores on the daily and weekly boards
+// above the new best are brought down to it, but archived season standings are left alone.
+func (a *AdminApi) correctHiscore(request *http.Request, actor string) (any, error) {
+	var body adminHiscoreCorrection
+	if err := readJson(request, &body); err != nil {
+		return nil, err
+	}
+	if body.BestScore < 0 || body.Reason == "" {
+		return nil, badRequest("best_score can't be negative, and reason is required")
+	}
+
+	dbTx := a.hub.NewDbTx()
+	player, err := dbTx.Queries.GetPlayerByExactName(dbTx.Ctx, request.PathValue("name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, notFound("no player found with name %s", request.PathValue("name"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting player: %w", err)
+	}
+
+	err = dbTx.Queries.UpdatePlayerBestScore(dbTx.Ctx, db.UpdatePlayerBestScoreParams{
+		BestScore: body.BestScore,
+		ID:        player.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating best score: %w", err)
+	}
+
+	capped, err := dbTx.Queries.CapPlayerScoreHistory(dbTx.Ctx, db.CapPlayerScoreHistoryParams{
+		Score:    body.BestScore,
+		PlayerID: player.ID,
+		Score_2:  body.BestScore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error capping score history: %w", err)
+	}
+
+	// Stop the player's own record of their best score from putting the old one back if they're in the game
+	a.hub.SharedGameObjects.Players.ForEach(func(_ uint64, online *objects.Player) {
+		if online.DbId == player.ID {
+			online.BestScore = body.BestScore
+		}
+	})
+
+	details := fmt.Sprintf("from %d to %d, reason: %s", player.BestScore, body.BestScore, body.Reason)
+	if err := LogModerationAction(dbTx, actor, "correct_score", player.Name, details); err != nil {
+		return nil, fmt.Errorf("error logging score correction: %w", err)
+	}
+
+	return map[string]any{
+		"name":                player.Name,
+		"previous_best_score": player.BestScore,
+		"best_score":          body.BestScore,
+		"scores_capped":       capped,
+	}, nil
+}
+
+func (a *AdminApi) getStats(request *http.Request, actor string) (any, error) {
+	return a.hub.Status(), nil
+}
+
+// The game rules which can be changed while the server is running
+type adminRules struct {
+	SporeTarget          int64    `json:"spore_target"`
+	DuplicateLoginPolicy string   `json:"duplicate_login_policy"`
+	ChatMaxLength        int      `json:"chat_max_length"`
+	ChatBurstSize        int      `json:"chat_burst_size"`
+	ChatBannedWords      []string `json:"chat_banned_words"`
+}
+
+// Changes to the game rules, where anything left out stays as it is
+type adminRulesUpdate struct {
+	SporeTarget          *int64    `json:"spore_target"`
+	DuplicateLoginPolicy *string   `json:"duplicate_login_policy"`
+	ChatMaxLength        *int      `json:"chat_max_length"`
+	ChatBurstSize        *int      `json:"chat_burst_size"`
+	ChatBannedWords      *[]string `json:"chat_banned_words"`
+}
+
+func (a *AdminApi) getRules(request *http.Request, actor string) (any, error) {
+	policy, chatFilter := a.hub.Settings()
+	bannedWords := chatFilter.BannedWords
+	if bannedWords == nil {
+		bannedWords = []string{}
+	}
+
+	return adminRules{
+		SporeTarget:          a.hub.SporeTarget(),
+		DuplicateLoginPolicy: policy.String(),
+		ChatMaxLength:        chatFilter.MaxLength,
+		ChatBurstSize:        chatFilter.BurstSize,
+		ChatBannedWords:      bannedWords,
+	}, nil
+}
+
+// Checks every change makes sense before applying any of them
+func (a *AdminApi) updateRules(request *http.Request, actor string) (any, error) {
+	var body adminRulesUpdate
+	if err := readJson(request, &body); err != nil {
+		return nil, err
+	}
+
+	policy, chatFilter := a.hub.Settings()
+	if body.DuplicateLoginPolicy != nil {
+		parsed, err := ParseDuplicateLoginPolicy(*body.DuplicateLoginPolicy)
+		if err != nil {
+			return nil, badRequest("%v", err)
+		}
+		policy = parsed
+	}
+	if body.ChatMaxLength != nil {
+		if *body.ChatMaxLength <= 0 {
+			return nil, badRequest("chat_max_length must be positive")
+		}
+		chatFilter.MaxLength = *body.ChatMaxLength
+	}
+	if body.ChatBurstSize != nil {
+		if *body.ChatBurstSize <= 0 {
+			return nil, badRequest("chat_burst_size must be positive")
+		}
+		chatFilter.BurstSize = *body.ChatBurstSize
+	}
+	if body.ChatBannedWords != nil {
+		chatFilter.BannedWords = *body.ChatBannedWords
+	}
+	if body.SporeTarget != nil {
+		if err := a.hub.SetSporeTarget(*body.SporeTarget); err != nil {
+			return nil, badRequest("%v", err)
+		}
+	}
+
+	a.hub.Reconfigure(policy, chatFilter)
+
+	changes, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("error describing rule changes: %w", err)
+	}
+	if err := LogModerationAction(a.hub.NewDbTx(), actor, "change_rules", "server", string(changes)); err != nil {
+		adminLogger.Errorf("Admin API: error logging rule changes: %v", err)
+	}
+
+	return a.getRules(request, actor)
+}
+
+func (a *AdminApi) getLogLevels(request *http.Request, actor string) (any, error) {
+	return logging.Levels(), nil
+}
+
+type adminLogLevel struct {
+	// debug, info, warn or error, or reset to go back to the default
+	Level string `json:"level"`
+}
+
+func (a *AdminApi) setLogLevel(request *http.Request, actor string) (any, error) {
+	var body adminLogLevel
+	if err := readJson(request, &body); err != nil {
+		return nil, err
+	}
+
+	subsystem := request.PathValue("subsystem")
+	if err := changeLogLevel(subsystem, body.Level); err != nil {
+		return nil, badRequest("%v", err)
+	}
+	adminLogger.Printf("Admin API: log level of %s changed to %s by %s", subsystem, body.Level, actor)
+
+	return logging.Levels(), nil
+}
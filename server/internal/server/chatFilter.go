@@ -0,0 +1,259 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The actor recorded in the moderation log for mutes issued automatically for flooding the chat
+const ChatFilterActor = "chat filter"
+
+var (
+	ErrChatEmpty     = errors.New("message is empty")
+	ErrChatTooLong   = errors.New("message is too long")
+	ErrChatTooFast   = errors.New("you're sending messages too quickly")
+	ErrChatDuplicate = errors.New("you already sent that message")
+)
+
+type ChatFilterConfig struct {
+	// The longest message allowed, in characters
+	MaxLength int
+
+	// How many messages can be sent in quick succession before being rate limited
+	BurstSize int
+
+	// How long it takes to earn back the allowance for one more message
+	RefillInterval time.Duration
+
+	// How long the same message can't be repeated for
+	DuplicateWindow time.Duration
+
+	// How many times someone can be caught flooding before they're muted
+	StrikesBeforeMute int
+
+	// How long each successive flood mute lasts. Once they run out, the last one is reused.
+	MuteDurations []time.Duration
+
+	// How long someone has to behave for their strikes and mute history to be forgotten
+	StrikeDecay time.Duration
+
+	// Words which will be masked out of messages, matched case-insensitively against whole words
+	BannedWords []string
+}
+
+var DefaultChatFilterConfig = ChatFilterConfig{
+	MaxLength:         200,
+	BurstSize:         5,
+	RefillInterval:    1500 * time.Millisecond,
+	DuplicateWindow:   30 * time.Second,
+	StrikesBeforeMute: 3,
+	MuteDurations:     []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute, 24 * time.Hour},
+	StrikeDecay:       10 * time.Minute,
+}
+
+// How a single account has been using the chat recently
+type chatHistory struct {
+	tokens       float64
+	lastRefill   time.Time
+	lastMessage  string
+	lastSentAt   time.Time
+	lastActiveAt time.Time
+	strikes      int
+	mutes        int
+	lastStrikeAt time.Time
+}
+
+// Thread-safe rate limiting, duplicate detection and word filtering for chat messages
+type ChatFilter struct {
+	config      ChatFilterConfig
+	bannedWords map[string]struct{}
+	histories   map[int64]*chatHistory
+	lastPrune   time.Time
+	mux         sync.Mutex
+}
+
+func NewChatFilter(config ChatFilterConfig) *ChatFilter {
+	return &ChatFilter{
+		config:      config,
+		bannedWords: bannedWordSet(config.BannedWords),
+		histories:   make(map[int64]*chatHistory),
+	}
+}
+
+// Switches to the new config, keeping track of how everyone has been using the chat so far
+func (f *ChatFilter) Reconfigure(config ChatFilterConfig) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.config = config
+	f.bannedWords = bannedWordSet(config.BannedWords)
+}
+
+func (f *ChatFilter) Config() ChatFilterConfig {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return f.config
+}
+
+func bannedWordSet(words []string) map[string]struct{} {
+	bannedWords := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			bannedWords[word] = struct{}{}
+		}
+	}
+	return bannedWords
+}
+
+// Check whether the account is allowed to send the message, and if so, return it with any banned words masked.
+// If the message is rejected because the account is flooding the chat, muteFor is how long the account should be
+// muted for, or zero if it's not time to mute them yet.
+func (f *ChatFilter) Check(accountId int64, text string) (filtered string, muteFor time.Duration, err error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", 0, ErrChatEmpty
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if utf8.RuneCountInString(text) > f.config.MaxLength {
+		return "", 0, ErrChatTooLong
+	}
+
+	now := time.Now()
+	f.pruneHistories(now)
+	history := f.history(accountId, now)
+
+	normalized := normalizeChat(text)
+	if normalized == history.lastMessage && now.Sub(history.lastSentAt) < f.config.DuplicateWindow {
+		return "", f.strike(history, now), ErrChatDuplicate
+	}
+
+	history.refill(now, f.config)
+	if history.tokens < 1 {
+		return "", f.strike(history, now), ErrChatTooFast
+	}
+
+	history.tokens--
+	history.lastMessage = normalized
+	history.lastSentAt = now
+	history.lastActiveAt = now
+
+	return f.maskBannedWords(text), 0, nil
+}
+
+// Check whether the account is allowed to run a chat command, which uses up the same allowance as sending a message.
+// Commands aren't checked for duplicates, since it's normal to run the same one again, e.g. /who. muteFor is as for Check.
+func (f *ChatFilter) CheckCommand(accountId int64) (muteFor time.Duration, err error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	now := time.Now()
+	f.pruneHistories(now)
+	history := f.history(accountId, now)
+
+	history.refill(now, f.config)
+	if history.tokens < 1 {
+		return f.strike(history, now), ErrChatTooFast
+	}
+
+	history.tokens--
+	history.lastActiveAt = now
+	return 0, nil
+}
+
+func (f *ChatFilter) history(accountId int64, now time.Time) *chatHistory {
+	history, exists := f.histories[accountId]
+	if !exists {
+		history = &chatHistory{tokens: float64(f.config.BurstSize), lastRefill: now}
+		f.histories[accountId] = history
+	}
+
+	if !history.lastStrikeAt.IsZero() && now.Sub(history.lastStrikeAt) > f.config.StrikeDecay {
+		history.strikes = 0
+		history.mutes = 0
+		history.lastStrikeAt = time.Time{}
+	}
+
+	return history
+}
+
+// Record that the account was caught flooding, and return how long to mute them for if they've run out of strikes
+func (f *ChatFilter) strike(history *chatHistory, now time.Time) time.Duration {
+	history.strikes++
+	history.lastStrikeAt = now
+	if history.strikes < f.config.StrikesBeforeMute || len(f.config.MuteDurations) == 0 {
+		return 0
+	}
+
+	muteFor := f.config.MuteDurations[min(history.mutes, len(f.config.MuteDurations)-1)]
+	history.strikes = 0
+	history.mutes++
+	return muteFor
+}
+
+func (h *chatHistory) refill(now time.Time, config ChatFilterConfig) {
+	if config.RefillInterval > 0 {
+		h.tokens += float64(now.Sub(h.lastRefill)) / float64(config.RefillInterval)
+	}
+	h.tokens = min(h.tokens, float64(config.BurstSize))
+	h.lastRefill = now
+}
+
+// Forget about accounts which haven't sent a message or run a command for long enough that they'd start from scratch
+// anyway
+func (f *ChatFilter) pruneHistories(now time.Time) {
+	if now.Sub(f.lastPrune) < f.config.StrikeDecay {
+		return
+	}
+	f.lastPrune = now
+
+	for accountId, history := range f.histories {
+		if now.Sub(history.lastActiveAt) > f.config.StrikeDecay && now.Sub(history.lastStrikeAt) > f.config.StrikeDecay {
+			delete(f.histories, accountId)
+		}
+	}
+}
+
+// Replace each banned word in the text with asterisks of the same length
+func (f *ChatFilter) maskBannedWords(text string) string {
+	if len(f.bannedWords) == 0 {
+		return text
+	}
+
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+
+		if _, banned := f.bannedWords[strings.ToLower(string(runes[start:end]))]; banned {
+			for i := start; i < end; i++ {
+				runes[i] = '*'
+			}
+		}
+		start = end
+	}
+
+	return string(runes)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Lowercase the text and collapse its whitespace, so trivial variations of a message still count as duplicates
+func normalizeChat(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
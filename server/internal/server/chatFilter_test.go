@@ -0,0 +1,240 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestChatHistoryRefill(t *testing.T) {
+	config := ChatFilterConfig{BurstSize: 5, RefillInterval: time.Second}
+	start := time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		tokens  float64
+		elapsed time.Duration
+		config  ChatFilterConfig
+		want    float64
+	}{
+		{"nothing earned straight away", 0, 0, config, 0},
+		{"part of a message earned", 0, 500 * time.Millisecond, config, 0.5},
+		{"one message per interval", 1, 3 * time.Second, config, 4},
+		{"capped at the burst size", 2, time.Hour, config, 5},
+		{"nothing earned without an interval", 1, time.Hour, ChatFilterConfig{BurstSize: 5}, 1},
+		{"lowered burst size takes effect", 5, 0, ChatFilterConfig{BurstSize: 2, RefillInterval: time.Second}, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := &chatHistory{tokens: test.tokens, lastRefill: start}
+			now := start.Add(test.elapsed)
+			history.refill(now, test.config)
+
+			if history.tokens != test.want {
+				t.Errorf("got %g tokens, want %g", history.tokens, test.want)
+			}
+			if !history.lastRefill.Equal(now) {
+				t.Errorf("last refill is %v, want %v", history.lastRefill, now)
+			}
+		})
+	}
+}
+
+func TestChatFilterRateLimit(t *testing.T) {
+	filter := NewChatFilter(ChatFilterConfig{
+		MaxLength:         200,
+		BurstSize:         2,
+		RefillInterval:    time.Hour,
+		DuplicateWindow:   time.Minute,
+		StrikesBeforeMute: 10,
+		StrikeDecay:       time.Hour,
+	})
+
+	for i := range 2 {
+		if _, _, err := filter.Check(1, fmt.Sprintf("message %d", i)); err != nil {
+			t.Fatalf("message %d within the burst was rejected: %v", i, err)
+		}
+	}
+	if _, _, err := filter.Check(1, "one too many"); !errors.Is(err, ErrChatTooFast) {
+		t.Fatalf("message past the burst got %v, want %v", err, ErrChatTooFast)
+	}
+
+	// Other accounts have their own allowance
+	if _, _, err := filter.Check(2, "someone else"); err != nil {
+		t.Fatalf("another account was rejected: %v", err)
+	}
+
+	// Pretend an interval has passed, rather than waiting for it
+	filter.mux.Lock()
+	filter.histories[1].lastRefill = filter.histories[1].lastRefill.Add(-time.Hour)
+	filter.mux.Unlock()
+
+	if _, _, err := filter.Check(1, "after a refill"); err != nil {
+		t.Fatalf("message after the allowance refilled was rejected: %v", err)
+	}
+	if _, _, err := filter.Check(1, "only one refilled"); !errors.Is(err, ErrChatTooFast) {
+		t.Fatalf("second message after one refill got %v, want %v", err, ErrChatTooFast)
+	}
+}
+
+func TestChatFilterKeepsCommandOnlyHistories(t *testing.T) {
+	filter := NewChatFilter(ChatFilterConfig{BurstSize: 1, RefillInterval: time.Hour, StrikesBeforeMute: 10, StrikeDecay: time.Minute})
+
+	if _, err := filter.CheckCommand(1); err != nil {
+		t.Fatalf("first command was rejected: %v", err)
+	}
+
+	// Pretend it's time to prune again, without the account having gone quiet for long
+	filter.mux.Lock()
+	filter.lastPrune = filter.lastPrune.Add(-2 * time.Minute)
+	filter.mux.Unlock()
+
+	if _, err := filter.CheckCommand(1); !errors.Is(err, ErrChatTooFast) {
+		t.Fatalf("command past the burst after pruning got %v, want %v", err, ErrChatTooFast)
+	}
+
+	// Once the account has been quiet for long enough, its history is forgotten
+	filter.mux.Lock()
+	filter.lastPrune = filter.lastPrune.Add(-2 * time.Minute)
+	filter.histories[1].lastActiveAt = filter.histories[1].lastActiveAt.Add(-2 * time.Minute)
+	filter.histories[1].lastStrikeAt = filter.histories[1].lastStrikeAt.Add(-2 * time.Minute)
+	filter.pruneHistories(time.Now())
+	_, remembered := filter.histories[1]
+	filter.mux.Unlock()
+
+	if remembered {
+		t.Errorf("history of a quiet account wasn't pruned")
+	}
+}
+
+func TestChatFilterDuplicates(t *testing.T) {
+	filter := NewChatFilter(ChatFilterConfig{
+		MaxLength:         200,
+		BurstSize:         10,
+		RefillInterval:    time.Second,
+		DuplicateWindow:   time.Minute,
+		StrikesBeforeMute: 10,
+		StrikeDecay:       time.Minute,
+	})
+
+	if _, _, err := filter.Check(1, "Hello  there"); err != nil {
+		t.Fatalf("first message was rejected: %v", err)
+	}
+	if _, _, err := filter.Check(1, " hello THERE "); !errors.Is(err, ErrChatDuplicate) {
+		t.Errorf("repeat with different case and spacing got %v, want %v", err, ErrChatDuplicate)
+	}
+	if _, _, err := filter.Check(2, "hello there"); err != nil {
+		t.Errorf("same message from another account was rejected: %v", err)
+	}
+	if _, _, err := filter.Check(1, "something else"); err != nil {
+		t.Errorf("different message was rejected: %v", err)
+	}
+}
+
+func TestChatFilterStrikeEscalation(t *testing.T) {
+	filter := NewChatFilter(ChatFilterConfig{
+		MaxLength:         200,
+		BurstSize:         1,
+		RefillInterval:    time.Hour,
+		DuplicateWindow:   time.Minute,
+		StrikesBeforeMute: 2,
+		MuteDurations:     []time.Duration{time.Minute, 5 * time.Minute},
+		StrikeDecay:       time.Hour,
+	})
+
+	if _, _, err := filter.Check(1, "allowed"); err != nil {
+		t.Fatalf("first message was rejected: %v", err)
+	}
+
+	// Every other strike mutes, for longer each time until the last duration is reached, which is then reused
+	want := []time.Duration{0, time.Minute, 0, 5 * time.Minute, 0, 5 * time.Minute}
+	for i, wantMute := range want {
+		_, muteFor, err := filter.Check(1, fmt.Sprintf("flood %d", i))
+		if !errors.Is(err, ErrChatTooFast) {
+			t.Fatalf("flood %d got %v, want %v", i, err, ErrChatTooFast)
+		}
+		if muteFor != wantMute {
+			t.Errorf("flood %d muted for %v, want %v", i, muteFor, wantMute)
+		}
+	}
+
+	// Commands use up the same allowance, and count as strikes too
+	if muteFor, err := filter.CheckCommand(1); !errors.Is(err, ErrChatTooFast) || muteFor != 0 {
+		t.Errorf("command while flooding got (%v, %v), want (0, %v)", muteFor, err, ErrChatTooFast)
+	}
+	if muteFor, err := filter.CheckCommand(1); !errors.Is(err, ErrChatTooFast) || muteFor != 5*time.Minute {
+		t.Errorf("second command while flooding got (%v, %v), want (%v, %v)", muteFor, err, 5*time.Minute, ErrChatTooFast)
+	}
+}
+
+func TestChatFilterStrikeDecay(t *testing.T) {
+	config := ChatFilterConfig{BurstSize: 1, StrikesBeforeMute: 2, MuteDurations: []time.Duration{time.Minute}, StrikeDecay: time.Minute}
+	filter := NewChatFilter(config)
+	start := time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC)
+
+	history := filter.history(1, start)
+	filter.strike(history, start)
+	filter.strike(history, start)
+	if history.strikes != 0 || history.mutes != 1 {
+		t.Fatalf("after two strikes got %d strikes and %d mutes, want 0 and 1", history.strikes, history.mutes)
+	}
+	filter.strike(history, start)
+
+	if history := filter.history(1, start.Add(config.StrikeDecay)); history.strikes != 1 || history.mutes != 1 {
+		t.Errorf("just before decaying got %d strikes and %d mutes, want 1 and 1", history.strikes, history.mutes)
+	}
+	if history := filter.history(1, start.Add(config.StrikeDecay+time.Second)); history.strikes != 0 || history.mutes != 0 {
+		t.Errorf("after decaying got %d strikes and %d mutes, want 0 and 0", history.strikes, history.mutes)
+	}
+}
+
+func TestChatFilterMasksBannedWords(t *testing.T) {
+	filter := NewChatFilter(ChatFilterConfig{
+		MaxLength:   200,
+		BurstSize:   100,
+		BannedWords: []string{"darn", " Heck ", "", "naïve"},
+	})
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"darn it", "**** it"},
+		{"DARN it", "**** it"},
+		{"what the heck!", "what the ****!"},
+		{"darn,darn.darn", "****,****.****"},
+		{"darning and heckle are fine", "darning and heckle are fine"},
+		{"so naïve", "so *****"},
+		{"nothing to see here", "nothing to see here"},
+	}
+
+	for i, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			got, _, err := filter.Check(int64(i), test.text)
+			if err != nil {
+				t.Fatalf("message was rejected: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChatFilterRejectsEmptyAndLongMessages(t *testing.T) {
+	filter := NewChatFilter(ChatFilterConfig{MaxLength: 5, BurstSize: 10})
+
+	if _, _, err := filter.Check(1, "   "); !errors.Is(err, ErrChatEmpty) {
+		t.Errorf("blank message got %v, want %v", err, ErrChatEmpty)
+	}
+	if _, _, err := filter.Check(1, "toolong"); !errors.Is(err, ErrChatTooLong) {
+		t.Errorf("long message got %v, want %v", err, ErrChatTooLong)
+	}
+
+	// Length is counted in characters rather than bytes
+	if _, _, err := filter.Check(1, "ééééé"); err != nil {
+		t.Errorf("message at the limit in multi-byte characters was rejected: %v", err)
+	}
+}
@@ -1,25 +1,41 @@
 package clients
 
 import (
-	"fmt"
-	"log"
+	"net"
 	"net/http"
 	"server/internal/server"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
 	"server/internal/server/states"
+	"server/internal/server/tracing"
 	"server/pkg/packets"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/proto"
 )
 
+var clientLogger = logging.Subsystem("client")
+
+// How long a kicked client's connection is left open for it to be told why, before it's closed anyway
+const kickGracePeriod = time.Second
+
 type WebSocketClient struct {
-	id       uint64
-	conn     *websocket.Conn
-	hub      *server.Hub
-	sendChan chan *packets.Packet
-	state    server.ClientStateHandler
-	logger   *log.Logger
-	dbTx     *server.DbTx
+	id        uint64
+	conn      *websocket.Conn
+	hub       *server.Hub
+	sendChan  chan *packets.Packet
+	state     server.ClientStateHandler
+	logger    *logging.Logger
+	dbTx      *server.DbTx
+	accountId int64
+	ipAddress string
+
+	// The span of the message being handled, if it's being traced, which the queries it runs and the messages it
+	// passes on are traced under. Peers can pass messages to a client while it's handling its own, in which case
+	// everything is traced under whichever message it started handling first.
+	activeSpan atomic.Pointer[tracing.Span]
 }
 
 func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
@@ -35,13 +51,19 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 		return nil, err
 	}
 
+	ipAddress, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		ipAddress = request.RemoteAddr
+	}
+
 	c := &WebSocketClient{
-		hub:      hub,
-		conn:     conn,
-		sendChan: make(chan *packets.Packet, 256),
-		logger:   log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
-		dbTx:     hub.NewDbTx(),
+		hub:       hub,
+		conn:      conn,
+		sendChan:  make(chan *packets.Packet, 256),
+		logger:    clientLogger.With("ip_address", ipAddress),
+		ipAddress: ipAddress,
 	}
+	c.dbTx = hub.NewTracedDbTx(c.activeSpan.Load)
 
 	return c, nil
 }
@@ -62,7 +84,14 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 		newStateName = state.Name()
 	}
 
-	c.logger.Printf("Switching from state %s to %s", prevStateName, newStateName)
+	c.logger.Debugf("Switching from state %s to %s", prevStateName, newStateName)
+
+	if prevStateName != "None" {
+		metrics.ClientsByState.Add(-1, prevStateName)
+	}
+	if newStateName != "None" {
+		metrics.ClientsByState.Add(1, newStateName)
+	}
 
 	c.state = state
 
@@ -72,13 +101,27 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 	}
 }
 
-func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
+func (c *WebSocketClient) ProcessMessage(span *tracing.Span, senderId uint64, message packets.Msg) {
+	span = span.StartChild("ProcessMessage",
+		"client_id", c.id,
+		"sender_id", senderId,
+		"message", metrics.MessageType(message),
+	)
+	defer span.End()
+
+	handlerSpan := span.StartChild("HandleMessage", "state", c.state.Name())
+	defer handlerSpan.End()
+
+	if handlerSpan != nil && c.activeSpan.CompareAndSwap(nil, handlerSpan) {
+		defer c.activeSpan.Store(nil)
+	}
+
 	c.state.HandleMessage(senderId, message)
 }
 
 func (c *WebSocketClient) Initialize(id uint64) {
 	c.id = id
-	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
+	c.logger = c.logger.With("client_id", c.id)
 	c.SetState(&states.Connected{})
 }
 
@@ -90,18 +133,23 @@ func (c *WebSocketClient) SocketSendAs(message packets.Msg, senderId uint64) {
 	select {
 	case c.sendChan <- &packets.Packet{SenderId: senderId, Msg: message}:
 	default:
-		c.logger.Printf("Send channel full, dropping message: %T", message)
+		c.logger.Warnf("Send channel full, dropping message: %T", message)
+		metrics.PacketsDropped.Inc(metrics.MessageType(message))
 	}
 }
 
 func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint64) {
 	if peer, exists := c.hub.Clients.Get(peerId); exists {
-		peer.ProcessMessage(c.id, message)
+		span := c.activeSpan.Load().StartChild("PassToPeer", "peer_id", peerId, "message", metrics.MessageType(message))
+		peer.ProcessMessage(span, c.id, message)
+		span.End()
 	}
 }
 
 func (c *WebSocketClient) Broadcast(message packets.Msg) {
-	c.hub.BroadcastChan <- &packets.Packet{SenderId: c.id, Msg: message}
+	// Ended by the hub once every other client has processed the message
+	span := c.activeSpan.Load().StartChild("Broadcast", "message", metrics.MessageType(message))
+	c.hub.BroadcastChan <- &server.Broadcast{Packet: &packets.Packet{SenderId: c.id, Msg: message}, Span: span}
 }
 
 func (c *WebSocketClient) ReadPump() {
@@ -112,9 +160,10 @@ func (c *WebSocketClient) ReadPump() {
 
 	for {
 		_, data, err := c.conn.ReadMessage()
+		readAt := time.Now()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				c.logger.Printf("Error: %v", err)
+				c.logger.Errorf("Error: %v", err)
 			}
 			break
 		}
@@ -122,16 +171,23 @@ func (c *WebSocketClient) ReadPump() {
 		packet := &packets.Packet{}
 		err = proto.Unmarshal(data, packet)
 		if err != nil {
-			c.logger.Printf("error unmarshalling data: %v", err)
+			c.logger.Errorf("error unmarshalling data: %v", err)
 			continue
 		}
 
+		messageType := metrics.MessageType(packet.Msg)
+		metrics.PacketsReceived.Inc(messageType)
+
+		span := tracing.StartTrace(messageType, "ReadPump", readAt, "client_id", c.id, "bytes", len(data))
+		span.AddChild("decode", readAt)
+
 		// To allow the client to lazily not send the sender ID, we'll assume they want to send it as themselves
 		if packet.SenderId == 0 {
 			packet.SenderId = c.id
 		}
 
-		c.ProcessMessage(packet.SenderId, packet.Msg)
+		c.ProcessMessage(span, packet.SenderId, packet.Msg)
+		span.End()
 	}
 }
 
@@ -144,28 +200,36 @@ func (c *WebSocketClient) WritePump() {
 	for packet := range c.sendChan {
 		writer, err := c.conn.NextWriter(websocket.BinaryMessage)
 		if err != nil {
-			c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
+			c.logger.Errorf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
 			return
 		}
 
 		data, err := proto.Marshal(packet)
 		if err != nil {
-			c.logger.Printf("error marshalling %T packet, closing client: %v", packet.Msg, err)
+			c.logger.Errorf("error marshalling %T packet, closing client: %v", packet.Msg, err)
 			continue
 		}
 
 		_, err = writer.Write(data)
 		if err != nil {
-			c.logger.Printf("error writing %T packet: %v", packet.Msg, err)
+			c.logger.Errorf("error writing %T packet: %v", packet.Msg, err)
 			continue
 		}
 
 		writer.Write([]byte{'\n'})
 
 		if err = writer.Close(); err != nil {
-			c.logger.Printf("error closing writer for %T packet: %v", packet.Msg, err)
+			c.logger.Errorf("error closing writer for %T packet: %v", packet.Msg, err)
 			continue
 		}
+
+		metrics.PacketsSent.Inc(metrics.MessageType(packet.Msg))
+		metrics.BytesSent.Add(float64(len(data) + 1))
+
+		// A disconnect message from ourselves means we've been kicked, so stop now that the client knows why
+		if _, kicked := packet.Msg.(*packets.Packet_Disconnect); kicked && packet.SenderId == c.id {
+			return
+		}
 	}
 }
 
@@ -173,10 +237,60 @@ func (c *WebSocketClient) DbTx() *server.DbTx {
 	return c.dbTx
 }
 
+func (c *WebSocketClient) ClaimAccount(userId int64) error {
+	if err := c.hub.ClaimAccount(userId, c); err != nil {
+		return err
+	}
+	c.accountId = userId
+	return nil
+}
+
+func (c *WebSocketClient) ReleaseAccount() {
+	c.hub.ReleaseAccount(c.id)
+	c.accountId = 0
+}
+
+func (c *WebSocketClient) AccountId() int64 {
+	return c.accountId
+}
+
+func (c *WebSocketClient) IpAddress() string {
+	return c.ipAddress
+}
+
+func (c *WebSocketClient) Kick(reason string) {
+	c.logger.Printf("Kicking client because: %s", reason)
+
+	// Try to tell the client why, but close the connection either way, since a client which isn't reading its messages
+	// would never get as far as the disconnect
+	select {
+	case c.sendChan <- &packets.Packet{SenderId: c.id, Msg: packets.NewDisconnect(reason)}:
+		time.AfterFunc(kickGracePeriod, func() {
+			c.conn.Close()
+		})
+	default:
+		c.logger.Warnf("Send channel full, closing connection without telling the client why it was kicked")
+		metrics.PacketsDropped.Inc(metrics.MessageType(&packets.Packet_Disconnect{}))
+		c.conn.Close()
+	}
+}
+
 func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
 	return c.hub.SharedGameObjects
 }
 
+func (c *WebSocketClient) ChatFilter() *server.ChatFilter {
+	return c.hub.ChatFilter
+}
+
+func (c *WebSocketClient) Stats() *server.StatsAggregator {
+	return c.hub.Stats
+}
+
+func (c *WebSocketClient) Achievements() *server.Achievements {
+	return c.hub.Achievements
+}
+
 func (c *WebSocketClient) Close(reason string) {
 	c.logger.Printf("Closing client connection because: %s", reason)
 
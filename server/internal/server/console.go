@@ -0,0 +1,398 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"server/internal/server/logging"
+	"server/internal/server/objects"
+	"server/internal/server/tracing"
+	"server/pkg/packets"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var adminLogger = logging.Subsystem("admin")
+
+// The actor recorded in the moderation log for actions taken with the admin console
+const AdminConsoleActor = "admin console"
+
+// Returned by a console command when it's given the wrong arguments
+var errConsoleUsage = errors.New("wrong arguments")
+
+type consoleCommand struct {
+	usage string
+	help  string
+	run   func(c *Console, args []string) (any, error)
+}
+
+// What the console writes back for each command, as a single JSON object
+type ConsoleResponse struct {
+	Ok      bool   `json:"ok"`
+	Command string `json:"command,omitempty"`
+	Result  any    `json:"result,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Usage   string `json:"usage,omitempty"`
+}
+
+// Lets an admin operate the running server from its terminal or a local Unix socket, one command per line
+type Console struct {
+	hub *Hub
+
+	// Reloads the server's config and applies it to the hub, returning what was applied
+	reload func() (any, error)
+
+	commands map[string]consoleCommand
+}
+
+func NewConsole(hub *Hub, reload func() (any, error)) *Console {
+	return &Console{
+		hub:    hub,
+		reload: reload,
+		commands: map[string]consoleCommand{
+			"help": {
+				usage: "help [command]",
+				help:  "List the commands, or show how to use one of them",
+				run:   consoleHelp,
+			},
+			"clients": {
+				usage: "clients",
+				help:  "List the connected clients, and the players of those in the game",
+				run:   consoleClients,
+			},
+			"kick": {
+				usage: "kick <client id|player name> [reason...]",
+				help:  "Disconnect a client",
+				run:   consoleKick,
+			},
+			"announce": {
+				usage: "announce <message...>",
+				help:  "Send a system message to everyone connected",
+				run:   consoleAnnounce,
+			},
+			"spores": {
+				usage: "spores [target]",
+				help:  "Show how many spores there are, or change how many the world is kept topped up with",
+				run:   consoleSpores,
+			},
+			"arenas": {
+				usage: "arenas",
+				help:  "Show how many players are in each arena and how big they are, along with the server's totals",
+				run:   consoleArenas,
+			},
+			"log-level": {
+				usage: "log-level [subsystem|default] [debug|info|warn|error|reset]",
+				help:  "Show the log levels, or change the level of a subsystem or of every subsystem without its own",
+				run:   consoleLogLevel,
+			},
+			"tracing": {
+				usage: "tracing [sample rate] [message types...]",
+				help:  "Show how packets are traced, or change the fraction traced and the message types traced every time",
+				run:   consoleTracing,
+			},
+			"reload": {
+				usage: "reload",
+				help:  "Reload the config file and apply the chat filter, duplicate login policy, log levels and trace sampling from it",
+				run:   consoleReload,
+			},
+		},
+	}
+}
+
+// Runs a single line of input as a command
+func (c *Console) Execute(line string) ConsoleResponse {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ConsoleResponse{Error: "no command given, try help"}
+	}
+
+	name, args := strings.ToLower(fields[0]), fields[1:]
+	cmd, exists := c.commands[name]
+	if !exists {
+		return ConsoleResponse{Command: name, Error: fmt.Sprintf("unknown command %s, try help", name)}
+	}
+
+	result, err := cmd.run(c, args)
+	if errors.Is(err, errConsoleUsage) {
+		return ConsoleResponse{Command: name, Error: err.Error(), Usage: cmd.usage}
+	}
+	if err != nil {
+		return ConsoleResponse{Command: name, Error: err.Error()}
+	}
+	return ConsoleResponse{Ok: true, Command: name, Result: result}
+}
+
+// Runs each line read as a command and writes the response, until the reader runs out. Responses are indented for
+// reading in a terminal, otherwise they're written one per line for scripts to parse.
+func (c *Console) Serve(reader io.Reader, writer io.Writer, interactive bool) {
+	encoder := json.NewEncoder(writer)
+	if interactive {
+		encoder.SetIndent("", "  ")
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for {
+		if interactive {
+			fmt.Fprint(writer, "> ")
+		}
+		if !scanner.Scan() {
+			break
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		adminLogger.Printf("Admin console: %s", line)
+		if err := encoder.Encode(c.Execute(line)); err != nil {
+			adminLogger.Errorf("Error writing admin console response: %v", err)
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		adminLogger.Errorf("Error reading admin console input: %v", err)
+	}
+}
+
+// Accepts admin console connections on a Unix socket at the path, which only the user running the server can
+// connect to. Any socket left behind at the path by a previous run is replaced.
+func (c *Console) ListenUnix(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing old socket: %w", err)
+	}
+
+	listener, err := listenUnixPrivate(path)
+	if err != nil {
+		return err
+	}
+
+	// Already the case where the socket was created with a restrictive umask, but make sure of it everywhere else
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return fmt.Errorf("error restricting access to socket: %w", err)
+	}
+
+	adminLogger.Printf("Admin console listening on %s", path)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				adminLogger.Errorf("Error accepting admin console connection, no longer listening: %v", err)
+				return
+			}
+			go func() {
+				defer conn.Close()
+				c.Serve(conn, conn, false)
+			}()
+		}
+	}()
+	return nil
+}
+
+type consoleCommandHelp struct {
+	Name  string `json:"name"`
+	Usage string `json:"usage"`
+	Help  string `json:"help"`
+}
+
+func consoleHelp(c *Console, args []string) (any, error) {
+	if len(args) > 1 {
+		return nil, errConsoleUsage
+	}
+
+	if len(args) == 1 {
+		name := strings.ToLower(args[0])
+		cmd, exists := c.commands[name]
+		if !exists {
+			return nil, fmt.Errorf("unknown command %s", name)
+		}
+		return consoleCommandHelp{Name: name, Usage: cmd.usage, Help: cmd.help}, nil
+	}
+
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	commands := make([]consoleCommandHelp, 0, len(names))
+	for _, name := range names {
+		cmd := c.commands[name]
+		commands = append(commands, consoleCommandHelp{Name: name, Usage: cmd.usage, Help: cmd.help})
+	}
+	return commands, nil
+}
+
+func consoleClients(c *Console, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, errConsoleUsage
+	}
+	return c.hub.ClientSummaries(), nil
+}
+
+// Finds a connected client by its ID, or by the name of its player if it's in the game
+func (c *Console) findClient(idOrName string) (uint64, ClientInterfacer, bool) {
+	if id, err := strconv.ParseUint(idOrName, 10, 64); err == nil {
+		if client, exists := c.hub.Clients.Get(id); exists {
+			return id, client, true
+		}
+	}
+
+	var foundId uint64
+	c.hub.SharedGameObjects.Players.ForEach(func(id uint64, player *objects.Player) {
+		if strings.EqualFold(player.Name, idOrName) {
+			foundId = id
+		}
+	})
+	if foundId == 0 {
+		return 0, nil, false
+	}
+
+	client, exists := c.hub.Clients.Get(foundId)
+	return foundId, client, exists
+}
+
+func consoleKick(c *Console, args []string) (any, error) {
+	if len(args) < 1 {
+		return nil, errConsoleUsage
+	}
+
+	id, client, found := c.findClient(args[0])
+	if !found {
+		return nil, fmt.Errorf("no client found with ID or player name %s", args[0])
+	}
+
+	reason := "Kicked by an admin"
+	if len(args) > 1 {
+		reason = strings.Join(args[1:], " ")
+	}
+	client.Kick(reason)
+
+	if err := LogModerationAction(c.hub.NewDbTx(), AdminConsoleActor, "kick", args[0], reason); err != nil {
+		adminLogger.Errorf("Error logging kick of client %d: %v", id, err)
+	}
+	return map[string]any{"client_id": id, "reason": reason}, nil
+}
+
+func consoleAnnounce(c *Console, args []string) (any, error) {
+	if len(args) < 1 {
+		return nil, errConsoleUsage
+	}
+
+	msg := strings.Join(args, " ")
+	c.hub.BroadcastChan <- &Broadcast{Packet: &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewSystemMessage(fmt.Sprintf("[Announcement] %s", msg)),
+	}}
+
+	if err := LogModerationAction(c.hub.NewDbTx(), AdminConsoleActor, "announce", "everyone", msg); err != nil {
+		adminLogger.Errorf("Error logging announcement: %v", err)
+	}
+	return map[string]any{"message": msg, "recipients": c.hub.Clients.Len()}, nil
+}
+
+func consoleSpores(c *Console, args []string) (any, error) {
+	if len(args) > 1 {
+		return nil, errConsoleUsage
+	}
+
+	if len(args) == 1 {
+		target, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return nil, errConsoleUsage
+		}
+		if err := c.hub.SetSporeTarget(target); err != nil {
+			return nil, err
+		}
+		adminLogger.Printf("Spore target changed to %d by the admin console", target)
+	}
+
+	return map[string]any{"count": c.hub.SharedGameObjects.Spores.Len(), "target": c.hub.SporeTarget()}, nil
+}
+
+func consoleArenas(c *Console, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, errConsoleUsage
+	}
+	return c.hub.Status(), nil
+}
+
+func consoleLogLevel(c *Console, args []string) (any, error) {
+	if len(args) > 2 {
+		return nil, errConsoleUsage
+	}
+
+	if len(args) == 2 {
+		if err := changeLogLevel(args[0], args[1]); err != nil {
+			return nil, err
+		}
+		adminLogger.Printf("Log level of %s changed to %s by the admin console", args[0], args[1])
+	}
+
+	levels := logging.Levels()
+	if len(args) == 0 {
+		return levels, nil
+	}
+	for _, level := range levels {
+		if level.Name == args[0] {
+			return level, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown subsystem %s", args[0])
+}
+
+// Sets the subsystem's level, or puts it back to the default if the level is "reset"
+func changeLogLevel(subsystem, level string) error {
+	if level == "reset" {
+		if subsystem == "default" {
+			return errors.New("the default level can't be reset")
+		}
+		return logging.ResetLevel(subsystem)
+	}
+
+	parsed, err := logging.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	return logging.SetLevel(subsystem, parsed)
+}
+
+func consoleTracing(c *Console, args []string) (any, error) {
+	if len(args) > 0 {
+		if !tracing.Enabled() {
+			return nil, errors.New("tracing isn't set up, set TRACE_EXPORTER and restart the server")
+		}
+
+		rate, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return nil, errConsoleUsage
+		}
+		sampling := tracing.Sampling{Rate: rate, Always: args[1:]}
+		if err := tracing.SetSampling(sampling); err != nil {
+			return nil, err
+		}
+		adminLogger.Printf("Trace sampling changed to %g, always tracing %v, by the admin console", rate, sampling.Always)
+	}
+
+	return map[string]any{
+		"enabled":  tracing.Enabled(),
+		"exporter": tracing.ExporterName(),
+		"sampling": tracing.CurrentSampling(),
+	}, nil
+}
+
+func consoleReload(c *Console, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, errConsoleUsage
+	}
+	if c.reload == nil {
+		return nil, errors.New("this server can't reload its config")
+	}
+	return c.reload()
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package server
+
+import "net"
+
+// Listens on a Unix socket at the path. There's no umask to keep others out while it's created, so the caller has to
+// restrict its permissions afterwards.
+func listenUnixPrivate(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package server
+
+import (
+	"net"
+	"syscall"
+)
+
+// Listens on a Unix socket at the path which only the user running the server can connect to. The socket is created
+// with a umask that keeps everyone else out, so there's no moment where it has the usual permissions. The umask is
+// shared by the whole process, so anything else created at the same moment is kept private too, which does no harm.
+func listenUnixPrivate(path string) (net.Listener, error) {
+	oldMask := syscall.Umask(0177)
+	defer syscall.Umask(oldMask)
+	return net.Listen("unix", path)
+}
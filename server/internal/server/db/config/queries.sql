@@ -27,21 +27,621 @@ UPDATE players
 SET best_score = ?
 WHERE id = ?;
 
+-- name: RaisePlayerBestScore :exec
+UPDATE players
+SET best_score = ?
+WHERE id = ? AND best_score < ?;
+
 -- name: GetTopScores :many
-SELECT name, best_score
+SELECT name, best_score, CAST(RANK() OVER (ORDER BY best_score DESC) AS INTEGER) AS "rank"
 FROM players
-ORDER BY best_score DESC
+WHERE user_id NOT IN (SELECT user_id FROM account_deletions)
+ORDER BY best_score DESC, name COLLATE NOCASE
 LIMIT ?
 OFFSET ?;
 
--- name: GetPlayerByName :one
+-- name: CountRankedPlayers :one
+SELECT COUNT(*) FROM players
+WHERE user_id NOT IN (SELECT user_id FROM account_deletions);
+
+-- name: SearchPlayersByName :many
 SELECT * FROM players
-WHERE name LIKE ?
-LIMIT 1;
+WHERE name LIKE ? ESCAPE '\'
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+ORDER BY name = ? COLLATE NOCASE DESC, name LIKE ? ESCAPE '\' DESC, name LIKE ? ESCAPE '\' DESC, best_score DESC
+LIMIT ?;
+
+-- name: SearchPlayersByNameBetween :many
+SELECT * FROM players
+WHERE name LIKE ? ESCAPE '\'
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+AND id IN (SELECT player_id FROM score_history WHERE ended_at >= ? AND ended_at < ?)
+ORDER BY name = ? COLLATE NOCASE DESC, name LIKE ? ESCAPE '\' DESC, name LIKE ? ESCAPE '\' DESC, best_score DESC
+LIMIT ?;
+
+-- name: SearchPlayersByNameInSeason :many
+SELECT * FROM players
+WHERE name LIKE ? ESCAPE '\'
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+AND id IN (SELECT player_id FROM season_results WHERE season_id = ?)
+ORDER BY name = ? COLLATE NOCASE DESC, name LIKE ? ESCAPE '\' DESC, name LIKE ? ESCAPE '\' DESC, best_score DESC
+LIMIT ?;
 
 -- name: GetPlayerRank :one
 SELECT COUNT(*) + 1 as "rank" FROM players
-WHERE best_score >= (
+WHERE user_id NOT IN (SELECT user_id FROM account_deletions)
+AND best_score > (
     SELECT best_score FROM players p2
     WHERE p2.id = ?
+);
+
+-- name: GetPlayerById :one
+SELECT * FROM players
+WHERE id = ? LIMIT 1;
+
+-- name: CountPlayersWithName :one
+SELECT COUNT(*) FROM players
+WHERE name = ? COLLATE NOCASE
+AND id != ?;
+
+-- name: UpdatePlayerProfile :exec
+UPDATE players
+SET name = ?, color = ?
+WHERE id = ?;
+
+-- name: CreatePlayerNameHistory :exec
+INSERT INTO player_name_history (
+    player_id, name, changed_at
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: GetLastPlayerNameChange :one
+SELECT changed_at FROM player_name_history
+WHERE player_id = ?
+ORDER BY changed_at DESC
+LIMIT 1;
+
+
+-- name: GetPlayerNameHistory :many
+SELECT * FROM player_name_history
+WHERE player_id = ?
+ORDER BY changed_at;
+
+-- name: CreateAccountDeletion :exec
+INSERT INTO account_deletions (
+    user_id, requested_at, delete_after
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: GetAccountDeletion :one
+SELECT * FROM account_deletions
+WHERE user_id = ? LIMIT 1;
+
+-- name: DeleteAccountDeletion :exec
+DELETE FROM account_deletions
+WHERE user_id = ?;
+
+-- name: GetDueAccountDeletions :many
+SELECT user_id FROM account_deletions
+WHERE delete_after <= ?;
+
+-- name: DeletePlayerNameHistoryByUserId :exec
+DELETE FROM player_name_history
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+);
+
+-- name: DeletePlayerByUserId :exec
+DELETE FROM players
+WHERE user_id = ?;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?;
+
+-- name: GetUserRole :one
+SELECT role FROM user_roles
+WHERE user_id = ? LIMIT 1;
+
+-- name: SetUserRole :exec
+INSERT INTO user_roles (
+    user_id, role
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (user_id) DO UPDATE SET role = excluded.role;
+
+-- name: DeleteUserRole :exec
+DELETE FROM user_roles
+WHERE user_id = ?;
+
+-- name: GetUserById :one
+SELECT * FROM users
+WHERE id = ? LIMIT 1;
+
+-- name: GetPlayerByExactName :one
+SELECT * FROM players
+WHERE name = ? COLLATE NOCASE
+LIMIT 1;
+
+-- name: GetRankedPlayerByExactName :one
+SELECT * FROM players
+WHERE name = ? COLLATE NOCASE
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+LIMIT 1;
+
+-- name: CreateUserSanction :one
+INSERT INTO user_sanctions (
+    user_id, kind, reason, issued_by, issued_at, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: GetUserSanctions :many
+SELECT * FROM user_sanctions
+WHERE user_id = ? AND kind = ? AND revoked_at IS NULL;
+
+-- name: RevokeUserSanctions :execrows
+UPDATE user_sanctions
+SET revoked_at = ?, revoked_by = ?
+WHERE user_id = ? AND kind = ? AND revoked_at IS NULL;
+
+-- name: CreateIpBan :one
+INSERT INTO ip_bans (
+    ip_address, reason, issued_by, issued_at, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: GetIpBans :many
+SELECT * FROM ip_bans
+WHERE ip_address = ? AND revoked_at IS NULL;
+
+-- name: RevokeIpBans :execrows
+UPDATE ip_bans
+SET revoked_at = ?, revoked_by = ?
+WHERE ip_address = ? AND revoked_at IS NULL;
+
+-- name: CreateModerationLogEntry :exec
+INSERT INTO moderation_log (
+    actor, action, target, details, created_at
+) VALUES (
+    ?, ?, ?, ?, ?
+);
+
+-- name: GetModerationLog :many
+SELECT * FROM moderation_log
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: CreateLoginHistory :exec
+INSERT INTO login_history (
+    user_id, ip_address, logged_in_at
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: GetLastLoginIp :one
+SELECT ip_address FROM login_history
+WHERE user_id = ?
+ORDER BY logged_in_at DESC, id DESC
+LIMIT 1;
+
+-- name: GetLoginHistory :many
+SELECT * FROM login_history
+WHERE user_id = ?
+ORDER BY logged_in_at;
+
+-- name: DeleteLoginHistoryByUserId :exec
+DELETE FROM login_history
+WHERE user_id = ?;
+
+-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    sender_player_id, sender_name, channel, arena, target, msg, sent_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetRecentPublicChatMessages :many
+SELECT * FROM chat_messages
+WHERE channel = ? OR (channel = ? AND arena = ?)
+ORDER BY sent_at DESC, id DESC
+LIMIT ?;
+
+-- name: SearchChatMessages :many
+SELECT * FROM chat_messages
+WHERE sent_at >= ? AND sent_at <= ?
+ORDER BY sent_at DESC, id DESC
+LIMIT ?;
+
+-- name: SearchChatMessagesByPlayer :many
+SELECT * FROM chat_messages
+WHERE sender_player_id = ? AND sent_at >= ? AND sent_at <= ?
+ORDER BY sent_at DESC, id DESC
+LIMIT ?;
+
+-- name: GetChatMessagesByPlayerId :many
+SELECT * FROM chat_messages
+WHERE sender_player_id = ?
+ORDER BY sent_at, id;
+
+-- name: DeleteChatMessagesByUserId :exec
+DELETE FROM chat_messages
+WHERE sender_player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+);
+
+-- name: AnonymizeChatMessageTargets :exec
+UPDATE chat_messages
+SET target = ?
+WHERE target = ? COLLATE NOCASE;
+
+-- name: CreatePlayerReport :one
+INSERT INTO player_reports (
+    reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: CountPlayerReportsByReporterSince :one
+SELECT COUNT(*) FROM player_reports
+WHERE reporter_player_id = ? AND created_at >= ?;
+
+-- name: GetPlayerReport :one
+SELECT * FROM player_reports
+WHERE id = ? LIMIT 1;
+
+-- name: GetOpenPlayerReports :many
+SELECT * FROM player_reports
+WHERE resolved_at IS NULL
+ORDER BY created_at, id
+LIMIT ?;
+
+-- name: GetPlayerReports :many
+SELECT * FROM player_reports
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: ResolvePlayerReport :execrows
+UPDATE player_reports
+SET resolved_at = ?, resolved_by = ?, resolution = ?
+WHERE id = ? AND resolved_at IS NULL;
+
+-- name: GetPlayerReportsInvolvingUserId :many
+SELECT * FROM player_reports
+WHERE reporter_player_id IN (SELECT id FROM players WHERE user_id = ?)
+   OR reported_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: UpdatePlayerReportContext :exec
+UPDATE player_reports
+SET context = ?
+WHERE id = ?;
+
+-- name: AnonymizePlayerReportsAgainstUserId :exec
+UPDATE player_reports
+SET reported_name = ?, reported_player_id = 0
+WHERE reported_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: AnonymizePlayerReportsByUserId :exec
+UPDATE player_reports
+SET reporter_name = ?, reporter_player_id = 0
+WHERE reporter_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: CreatePlayerBlock :exec
+INSERT INTO player_blocks (
+    player_id, blocked_player_id, created_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (player_id, blocked_player_id) DO NOTHING;
+
+-- name: DeletePlayerBlock :execrows
+DELETE FROM player_blocks
+WHERE player_id = ? AND blocked_player_id = ?;
+
+-- name: GetPlayerBlocks :many
+SELECT players.id, players.name FROM player_blocks
+JOIN players ON players.id = player_blocks.blocked_player_id
+WHERE player_blocks.player_id = ?
+ORDER BY players.name COLLATE NOCASE;
+
+-- name: IsPlayerBlocked :one
+SELECT COUNT(*) FROM player_blocks
+WHERE player_id = ? AND blocked_player_id = ?;
+
+-- name: DeletePlayerBlocksByUserId :exec
+DELETE FROM player_blocks
+WHERE player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR blocked_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: CreateFriend :exec
+INSERT INTO friends (
+    player_id, friend_player_id, created_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (player_id, friend_player_id) DO NOTHING;
+
+-- name: DeleteFriend :execrows
+DELETE FROM friends
+WHERE (player_id = ? AND friend_player_id = ?)
+OR (player_id = ? AND friend_player_id = ?);
+
+-- name: GetFriends :many
+SELECT players.id, players.name FROM friends
+JOIN players ON players.id = friends.friend_player_id
+WHERE friends.player_id = ?
+ORDER BY players.name COLLATE NOCASE;
+
+-- name: CountFriends :one
+SELECT COUNT(*) FROM friends
+WHERE player_id = ?;
+
+-- name: IsFriend :one
+SELECT COUNT(*) FROM friends
+WHERE player_id = ? AND friend_player_id = ?;
+
+-- name: DeleteFriendsByUserId :exec
+DELETE FROM friends
+WHERE player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR friend_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: CreateFriendRequest :execrows
+INSERT INTO friend_requests (
+    requester_player_id, recipient_player_id, created_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (requester_player_id, recipient_player_id) DO NOTHING;
+
+-- name: DeleteFriendRequest :execrows
+DELETE FROM friend_requests
+WHERE requester_player_id = ? AND recipient_player_id = ?;
+
+-- name: GetIncomingFriendRequests :many
+SELECT players.id, players.name FROM friend_requests
+JOIN players ON players.id = friend_requests.requester_player_id
+WHERE friend_requests.recipient_player_id = ?
+ORDER BY friend_requests.created_at;
+
+-- name: GetOutgoingFriendRequests :many
+SELECT players.id, players.name FROM friend_requests
+JOIN players ON players.id = friend_requests.recipient_player_id
+WHERE friend_requests.requester_player_id = ?
+ORDER BY friend_requests.created_at;
+
+-- name: DeleteFriendRequestsByUserId :exec
+DELETE FROM friend_requests
+WHERE requester_player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR recipient_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: CreateScoreHistory :exec
+INSERT INTO score_history (
+    player_id, score, started_at, ended_at
+) VALUES (
+    ?, ?, ?, ?
+);
+
+-- name: GetTopScoresBetween :many
+SELECT players.id, players.name, CAST(MAX(score_history.score) AS INTEGER) AS best_score,
+    CAST(RANK() OVER (ORDER BY MAX(score_history.score) DESC) AS INTEGER) AS "rank"
+FROM score_history
+JOIN players ON players.id = score_history.player_id
+WHERE score_history.ended_at >= ? AND score_history.ended_at < ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+GROUP BY players.id
+ORDER BY best_score DESC, players.name COLLATE NOCASE
+LIMIT ?
+OFFSET ?;
+
+-- name: GetPlayerBestScoreBetween :one
+SELECT CAST(MAX(score) AS INTEGER) AS best_score FROM score_history
+WHERE player_id = ? AND ended_at >= ? AND ended_at < ?
+GROUP BY player_id;
+
+-- name: CountPlayersBeatingScoreBetween :one
+SELECT COUNT(*) FROM (
+    SELECT score_history.player_id FROM score_history
+    JOIN players ON players.id = score_history.player_id
+    WHERE score_history.ended_at >= ? AND score_history.ended_at < ?
+    AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+    GROUP BY score_history.player_id
+    HAVING MAX(score_history.score) > ?
+);
+
+-- name: CountPlayersBetween :one
+SELECT COUNT(DISTINCT score_history.player_id) FROM score_history
+JOIN players ON players.id = score_history.player_id
+WHERE score_history.ended_at >= ? AND score_history.ended_at < ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions);
+
+-- name: GetScoreHistoryByPlayerId :many
+SELECT * FROM score_history
+WHERE player_id = ?
+ORDER BY ended_at, id;
+
+-- name: CapPlayerScoreHistory :execrows
+UPDATE score_history
+SET score = ?
+WHERE player_id = ? AND score > ?;
+
+-- name: DeleteScoreHistoryByUserId :exec
+DELETE FROM score_history
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+);
+
+-- name: CreateSeason :one
+INSERT INTO seasons (
+    name, started_at
+) VALUES (
+    ?, ?
+)
+RETURNING *;
+
+-- name: GetCurrentSeason :one
+SELECT * FROM seasons
+WHERE ended_at IS NULL
+ORDER BY started_at DESC
+LIMIT 1;
+
+-- name: GetSeasonByName :one
+SELECT * FROM seasons
+WHERE name = ? COLLATE NOCASE
+LIMIT 1;
+
+-- name: GetSeasons :many
+SELECT * FROM seasons
+ORDER BY started_at DESC;
+
+-- name: EndSeason :execrows
+UPDATE seasons
+SET ended_at = ?
+WHERE id = ? AND ended_at IS NULL;
+
+-- name: CreateSeasonResult :exec
+INSERT INTO season_results (
+    season_id, player_id, name, score, rank
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+ON CONFLICT (season_id, player_id) DO UPDATE SET name = excluded.name, score = excluded.score, rank = excluded.rank;
+
+-- name: GetSeasonResults :many
+SELECT season_results.* FROM season_results
+JOIN players ON players.id = season_results.player_id
+WHERE season_results.season_id = ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+ORDER BY season_results.rank, season_results.name COLLATE NOCASE
+LIMIT ?
+OFFSET ?;
+
+-- name: CountSeasonResults :one
+SELECT COUNT(*) FROM season_results
+JOIN players ON players.id = season_results.player_id
+WHERE season_results.season_id = ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions);
+
+-- name: GetSeasonResult :one
+SELECT * FROM season_results
+WHERE season_id = ? AND player_id = ?
+LIMIT 1;
+
+-- name: DeleteSeasonResultsByUserId :exec
+DELETE FROM season_results
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+);
+
+-- name: CreateSessionStats :exec
+INSERT INTO session_stats (
+    player_id, started_at, ended_at, spores_eaten, players_eaten, times_eaten, seconds_alive, max_mass, distance_traveled
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetLifetimeStats :one
+SELECT
+    COUNT(*) AS sessions,
+    CAST(COALESCE(SUM(spores_eaten), 0) AS INTEGER) AS spores_eaten,
+    CAST(COALESCE(SUM(players_eaten), 0) AS INTEGER) AS players_eaten,
+    CAST(COALESCE(SUM(times_eaten), 0) AS INTEGER) AS times_eaten,
+    CAST(COALESCE(SUM(seconds_alive), 0) AS INTEGER) AS seconds_alive,
+    CAST(COALESCE(MAX(max_mass), 0) AS INTEGER) AS max_mass,
+    CAST(COALESCE(SUM(distance_traveled), 0) AS REAL) AS distance_traveled
+FROM session_stats
+WHERE player_id = ?;
+
+-- name: GetSessionStatsByPlayerId :many
+SELECT * FROM session_stats
+WHERE player_id = ?
+ORDER BY started_at, id;
+
+-- name: DeleteSessionStatsByUserId :exec
+DELETE FROM session_stats
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+);
+
+-- name: RecordPlayerKill :exec
+INSERT INTO player_kills (
+    killer_player_id, victim_player_id, kills, last_killed_at
+) VALUES (
+    ?, ?, 1, ?
+)
+ON CONFLICT (killer_player_id, victim_player_id) DO UPDATE SET kills = kills + 1, last_killed_at = excluded.last_killed_at;
+
+-- name: GetTopVictims :many
+SELECT players.name, player_kills.kills FROM player_kills
+JOIN players ON players.id = player_kills.victim_player_id
+WHERE player_kills.killer_player_id = ?
+ORDER BY player_kills.kills DESC, player_kills.last_killed_at DESC
+LIMIT ?;
+
+-- name: GetTopKillers :many
+SELECT players.name, player_kills.kills FROM player_kills
+JOIN players ON players.id = player_kills.killer_player_id
+WHERE player_kills.victim_player_id = ?
+ORDER BY player_kills.kills DESC, player_kills.last_killed_at DESC
+LIMIT ?;
+
+-- name: DeletePlayerKillsByUserId :exec
+DELETE FROM player_kills
+WHERE killer_player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR victim_player_id IN (SELECT id FROM players WHERE user_id = ?);
+
+-- name: CreatePlayerAchievement :execrows
+INSERT INTO player_achievements (
+    player_id, achievement_id, unlocked_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (player_id, achievement_id) DO NOTHING;
+
+-- name: GetPlayerAchievements :many
+SELECT * FROM player_achievements
+WHERE player_id = ?
+ORDER BY unlocked_at, achievement_id;
+
+-- name: DeletePlayerAchievementsByUserId :exec
+DELETE FROM player_achievements
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+);
+
+-- name: GetPlayerProgress :one
+SELECT * FROM player_progress
+WHERE player_id = ? LIMIT 1;
+
+-- name: AddPlayerXp :exec
+INSERT INTO player_progress (
+    player_id, xp
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE SET xp = xp + excluded.xp;
+
+-- name: SetPlayerBadge :exec
+INSERT INTO player_progress (
+    player_id, badge
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE SET badge = excluded.badge;
+
+-- name: UpdatePlayerColor :exec
+UPDATE players
+SET color = ?
+WHERE id = ?;
+
+-- name: DeletePlayerProgressByUserId :exec
+DELETE FROM player_progress
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
 );
\ No newline at end of file
@@ -11,4 +11,199 @@ CREATE TABLE IF NOT EXISTS players (
     best_score INTEGER NOT NULL DEFAULT 0,
     color INTEGER NOT NULL,
     FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS players_name ON players (name COLLATE NOCASE);
+
+CREATE TABLE IF NOT EXISTS player_name_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    changed_at INTEGER NOT NULL,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id INTEGER PRIMARY KEY,
+    requested_at INTEGER NOT NULL,
+    delete_after INTEGER NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id INTEGER PRIMARY KEY,
+    role TEXT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS user_sanctions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    issued_by TEXT NOT NULL,
+    issued_at INTEGER NOT NULL,
+    expires_at INTEGER,
+    revoked_at INTEGER,
+    revoked_by TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS ip_bans (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    ip_address TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    issued_by TEXT NOT NULL,
+    issued_at INTEGER NOT NULL,
+    expires_at INTEGER,
+    revoked_at INTEGER,
+    revoked_by TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS moderation_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL,
+    details TEXT NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS login_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    ip_address TEXT NOT NULL,
+    logged_in_at INTEGER NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS chat_messages (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    sender_player_id INTEGER NOT NULL,
+    sender_name TEXT NOT NULL,
+    channel INTEGER NOT NULL,
+    arena TEXT NOT NULL,
+    target TEXT NOT NULL,
+    msg TEXT NOT NULL,
+    sent_at INTEGER NOT NULL,
+    FOREIGN KEY (sender_player_id) REFERENCES players(id)
+);
+
+CREATE INDEX IF NOT EXISTS chat_messages_sent_at ON chat_messages (sent_at);
+
+CREATE TABLE IF NOT EXISTS player_reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    reporter_player_id INTEGER NOT NULL,
+    reporter_name TEXT NOT NULL,
+    reported_player_id INTEGER NOT NULL,
+    reported_name TEXT NOT NULL,
+    category INTEGER NOT NULL,
+    details TEXT NOT NULL,
+    context TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    resolved_at INTEGER,
+    resolved_by TEXT NOT NULL DEFAULT '',
+    resolution TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (reporter_player_id) REFERENCES players(id),
+    FOREIGN KEY (reported_player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS player_blocks (
+    player_id INTEGER NOT NULL,
+    blocked_player_id INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (player_id, blocked_player_id),
+    FOREIGN KEY (player_id) REFERENCES players(id),
+    FOREIGN KEY (blocked_player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS friends (
+    player_id INTEGER NOT NULL,
+    friend_player_id INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (player_id, friend_player_id),
+    FOREIGN KEY (player_id) REFERENCES players(id),
+    FOREIGN KEY (friend_player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS friend_requests (
+    requester_player_id INTEGER NOT NULL,
+    recipient_player_id INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (requester_player_id, recipient_player_id),
+    FOREIGN KEY (requester_player_id) REFERENCES players(id),
+    FOREIGN KEY (recipient_player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS score_history (
+    id INTEGER PRIMARY KEY,
+    player_id INTEGER NOT NULL,
+    score INTEGER NOT NULL,
+    started_at INTEGER NOT NULL,
+    ended_at INTEGER NOT NULL,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE INDEX IF NOT EXISTS score_history_ended_at ON score_history (ended_at);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id INTEGER PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    started_at INTEGER NOT NULL,
+    ended_at INTEGER
+);
+
+CREATE TABLE IF NOT EXISTS season_results (
+    season_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    score INTEGER NOT NULL,
+    rank INTEGER NOT NULL,
+    PRIMARY KEY (season_id, player_id),
+    FOREIGN KEY (season_id) REFERENCES seasons(id),
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS session_stats (
+    id INTEGER PRIMARY KEY,
+    player_id INTEGER NOT NULL,
+    started_at INTEGER NOT NULL,
+    ended_at INTEGER NOT NULL,
+    spores_eaten INTEGER NOT NULL,
+    players_eaten INTEGER NOT NULL,
+    times_eaten INTEGER NOT NULL,
+    seconds_alive INTEGER NOT NULL,
+    max_mass INTEGER NOT NULL,
+    distance_traveled REAL NOT NULL,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE INDEX IF NOT EXISTS session_stats_player_id ON session_stats (player_id);
+
+CREATE TABLE IF NOT EXISTS player_kills (
+    killer_player_id INTEGER NOT NULL,
+    victim_player_id INTEGER NOT NULL,
+    kills INTEGER NOT NULL,
+    last_killed_at INTEGER NOT NULL,
+    PRIMARY KEY (killer_player_id, victim_player_id),
+    FOREIGN KEY (killer_player_id) REFERENCES players(id),
+    FOREIGN KEY (victim_player_id) REFERENCES players(id)
+);
+
+CREATE INDEX IF NOT EXISTS players_best_score ON players (best_score);
+
+CREATE TABLE IF NOT EXISTS player_achievements (
+    player_id INTEGER NOT NULL,
+    achievement_id TEXT NOT NULL,
+    unlocked_at INTEGER NOT NULL,
+    PRIMARY KEY (player_id, achievement_id),
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS player_progress (
+    player_id INTEGER PRIMARY KEY,
+    xp INTEGER NOT NULL DEFAULT 0,
+    badge TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (player_id) REFERENCES players(id)
 );
\ No newline at end of file
@@ -4,6 +4,66 @@
 
 package db
 
+import (
+	"database/sql"
+)
+
+type AccountDeletion struct {
+	UserID      int64
+	RequestedAt int64
+	DeleteAfter int64
+}
+
+type ChatMessage struct {
+	ID             int64  `json:"id"`
+	SenderPlayerID int64  `json:"sender_player_id"`
+	SenderName     string `json:"sender_name"`
+	Channel        int64  `json:"channel"`
+	Arena          string `json:"arena"`
+	Target         string `json:"target"`
+	Msg            string `json:"msg"`
+	SentAt         int64  `json:"sent_at"`
+}
+
+type FriendRequest struct {
+	RequesterPlayerID int64 `json:"requester_player_id"`
+	RecipientPlayerID int64 `json:"recipient_player_id"`
+	CreatedAt         int64 `json:"created_at"`
+}
+
+type Friend struct {
+	PlayerID       int64 `json:"player_id"`
+	FriendPlayerID int64 `json:"friend_player_id"`
+	CreatedAt      int64 `json:"created_at"`
+}
+
+type IpBan struct {
+	ID        int64
+	IpAddress string
+	Reason    string
+	IssuedBy  string
+	IssuedAt  int64
+	ExpiresAt sql.NullInt64
+	RevokedAt sql.NullInt64
+	RevokedBy string
+}
+
+type LoginHistory struct {
+	ID         int64
+	UserID     int64
+	IpAddress  string
+	LoggedInAt int64
+}
+
+type ModerationLog struct {
+	ID        int64
+	Actor     string
+	Action    string
+	Target    string
+	Details   string
+	CreatedAt int64
+}
+
 type Player struct {
 	ID        int64
 	UserID    int64
@@ -12,8 +72,108 @@ type Player struct {
 	Color     int64
 }
 
+type PlayerAchievement struct {
+	PlayerID      int64  `json:"player_id"`
+	AchievementID string `json:"achievement_id"`
+	UnlockedAt    int64  `json:"unlocked_at"`
+}
+
+type PlayerBlock struct {
+	PlayerID        int64 `json:"player_id"`
+	BlockedPlayerID int64 `json:"blocked_player_id"`
+	CreatedAt       int64 `json:"created_at"`
+}
+
+type PlayerKill struct {
+	KillerPlayerID int64 `json:"killer_player_id"`
+	VictimPlayerID int64 `json:"victim_player_id"`
+	Kills          int64 `json:"kills"`
+	LastKilledAt   int64 `json:"last_killed_at"`
+}
+
+type PlayerNameHistory struct {
+	ID        int64
+	PlayerID  int64
+	Name      string
+	ChangedAt int64
+}
+
+type PlayerProgress struct {
+	PlayerID int64  `json:"player_id"`
+	Xp       int64  `json:"xp"`
+	Badge    string `json:"badge"`
+}
+
+type PlayerReport struct {
+	ID               int64         `json:"id"`
+	ReporterPlayerID int64         `json:"reporter_player_id"`
+	ReporterName     string        `json:"reporter_name"`
+	ReportedPlayerID int64         `json:"reported_player_id"`
+	ReportedName     string        `json:"reported_name"`
+	Category         int64         `json:"category"`
+	Details          string        `json:"details"`
+	Context          string        `json:"context"`
+	CreatedAt        int64         `json:"created_at"`
+	ResolvedAt       sql.NullInt64 `json:"resolved_at"`
+	ResolvedBy       string        `json:"resolved_by"`
+	Resolution       string        `json:"resolution"`
+}
+
+type ScoreHistory struct {
+	ID        int64 `json:"id"`
+	PlayerID  int64 `json:"player_id"`
+	Score     int64 `json:"score"`
+	StartedAt int64 `json:"started_at"`
+	EndedAt   int64 `json:"ended_at"`
+}
+
+type SeasonResult struct {
+	SeasonID int64  `json:"season_id"`
+	PlayerID int64  `json:"player_id"`
+	Name     string `json:"name"`
+	Score    int64  `json:"score"`
+	Rank     int64  `json:"rank"`
+}
+
+type Season struct {
+	ID        int64         `json:"id"`
+	Name      string        `json:"name"`
+	StartedAt int64         `json:"started_at"`
+	EndedAt   sql.NullInt64 `json:"ended_at"`
+}
+
+type SessionStat struct {
+	ID               int64   `json:"id"`
+	PlayerID         int64   `json:"player_id"`
+	StartedAt        int64   `json:"started_at"`
+	EndedAt          int64   `json:"ended_at"`
+	SporesEaten      int64   `json:"spores_eaten"`
+	PlayersEaten     int64   `json:"players_eaten"`
+	TimesEaten       int64   `json:"times_eaten"`
+	SecondsAlive     int64   `json:"seconds_alive"`
+	MaxMass          int64   `json:"max_mass"`
+	DistanceTraveled float64 `json:"distance_traveled"`
+}
+
 type User struct {
 	ID           int64
 	Username     string
 	PasswordHash string
 }
+
+type UserRole struct {
+	UserID int64
+	Role   string
+}
+
+type UserSanction struct {
+	ID        int64
+	UserID    int64
+	Kind      string
+	Reason    string
+	IssuedBy  string
+	IssuedAt  int64
+	ExpiresAt sql.NullInt64
+	RevokedAt sql.NullInt64
+	RevokedBy string
+}
@@ -7,137 +7,2584 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const addPlayerXp = `-- name: AddPlayerXp :exec
+INSERT INTO player_progress (
+    player_id, xp
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE SET xp = xp + excluded.xp
+`
+
+type AddPlayerXpParams struct {
+	PlayerID int64 `json:"player_id"`
+	Xp       int64 `json:"xp"`
+}
+
+func (q *Queries) AddPlayerXp(ctx context.Context, arg AddPlayerXpParams) error {
+	_, err := q.db.ExecContext(ctx, addPlayerXp, arg.PlayerID, arg.Xp)
+	return err
+}
+
+const anonymizeChatMessageTargets = `-- name: AnonymizeChatMessageTargets :exec
+UPDATE chat_messages
+SET target = ?
+WHERE target = ? COLLATE NOCASE
+`
+
+type AnonymizeChatMessageTargetsParams struct {
+	Target   string `json:"target"`
+	Target_2 string `json:"target_2"`
+}
+
+func (q *Queries) AnonymizeChatMessageTargets(ctx context.Context, arg AnonymizeChatMessageTargetsParams) error {
+	_, err := q.db.ExecContext(ctx, anonymizeChatMessageTargets, arg.Target, arg.Target_2)
+	return err
+}
+
+const anonymizePlayerReportsAgainstUserId = `-- name: AnonymizePlayerReportsAgainstUserId :exec
+UPDATE player_reports
+SET reported_name = ?, reported_player_id = 0
+WHERE reported_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type AnonymizePlayerReportsAgainstUserIdParams struct {
+	ReportedName string `json:"reported_name"`
+	UserID       int64  `json:"user_id"`
+}
+
+func (q *Queries) AnonymizePlayerReportsAgainstUserId(ctx context.Context, arg AnonymizePlayerReportsAgainstUserIdParams) error {
+	_, err := q.db.ExecContext(ctx, anonymizePlayerReportsAgainstUserId, arg.ReportedName, arg.UserID)
+	return err
+}
+
+const anonymizePlayerReportsByUserId = `-- name: AnonymizePlayerReportsByUserId :exec
+UPDATE player_reports
+SET reporter_name = ?, reporter_player_id = 0
+WHERE reporter_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type AnonymizePlayerReportsByUserIdParams struct {
+	ReporterName string `json:"reporter_name"`
+	UserID       int64  `json:"user_id"`
+}
+
+func (q *Queries) AnonymizePlayerReportsByUserId(ctx context.Context, arg AnonymizePlayerReportsByUserIdParams) error {
+	_, err := q.db.ExecContext(ctx, anonymizePlayerReportsByUserId, arg.ReporterName, arg.UserID)
+	return err
+}
+
+const capPlayerScoreHistory = `-- name: CapPlayerScoreHistory :execrows
+UPDATE score_history
+SET score = ?
+WHERE player_id = ? AND score > ?
+`
+
+type CapPlayerScoreHistoryParams struct {
+	Score    int64 `json:"score"`
+	PlayerID int64 `json:"player_id"`
+	Score_2  int64 `json:"score_2"`
+}
+
+func (q *Queries) CapPlayerScoreHistory(ctx context.Context, arg CapPlayerScoreHistoryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, capPlayerScoreHistory, arg.Score, arg.PlayerID, arg.Score_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countFriends = `-- name: CountFriends :one
+SELECT COUNT(*) FROM friends
+WHERE player_id = ?
+`
+
+func (q *Queries) CountFriends(ctx context.Context, playerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countFriends, playerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPlayerReportsByReporterSince = `-- name: CountPlayerReportsByReporterSince :one
+SELECT COUNT(*) FROM player_reports
+WHERE reporter_player_id = ? AND created_at >= ?
+`
+
+type CountPlayerReportsByReporterSinceParams struct {
+	ReporterPlayerID int64 `json:"reporter_player_id"`
+	CreatedAt        int64 `json:"created_at"`
+}
+
+func (q *Queries) CountPlayerReportsByReporterSince(ctx context.Context, arg CountPlayerReportsByReporterSinceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayerReportsByReporterSince, arg.ReporterPlayerID, arg.CreatedAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPlayersBeatingScoreBetween = `-- name: CountPlayersBeatingScoreBetween :one
+SELECT COUNT(*) FROM (
+    SELECT score_history.player_id FROM score_history
+    JOIN players ON players.id = score_history.player_id
+    WHERE score_history.ended_at >= ? AND score_history.ended_at < ?
+    AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+    GROUP BY score_history.player_id
+    HAVING MAX(score_history.score) > ?
+)
+`
+
+type CountPlayersBeatingScoreBetweenParams struct {
+	EndedAt   int64 `json:"ended_at"`
+	EndedAt_2 int64 `json:"ended_at_2"`
+	Score     int64 `json:"score"`
+}
+
+func (q *Queries) CountPlayersBeatingScoreBetween(ctx context.Context, arg CountPlayersBeatingScoreBetweenParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayersBeatingScoreBetween, arg.EndedAt, arg.EndedAt_2, arg.Score)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPlayersBetween = `-- name: CountPlayersBetween :one
+SELECT COUNT(DISTINCT score_history.player_id) FROM score_history
+JOIN players ON players.id = score_history.player_id
+WHERE score_history.ended_at >= ? AND score_history.ended_at < ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+`
+
+type CountPlayersBetweenParams struct {
+	EndedAt   int64 `json:"ended_at"`
+	EndedAt_2 int64 `json:"ended_at_2"`
+}
+
+func (q *Queries) CountPlayersBetween(ctx context.Context, arg CountPlayersBetweenParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayersBetween, arg.EndedAt, arg.EndedAt_2)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPlayersWithName = `-- name: CountPlayersWithName :one
+SELECT COUNT(*) FROM players
+WHERE name = ? COLLATE NOCASE
+AND id != ?
+`
+
+type CountPlayersWithNameParams struct {
+	Name string
+	ID   int64
+}
+
+func (q *Queries) CountPlayersWithName(ctx context.Context, arg CountPlayersWithNameParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayersWithName, arg.Name, arg.ID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRankedPlayers = `-- name: CountRankedPlayers :one
+SELECT COUNT(*) FROM players
+WHERE user_id NOT IN (SELECT user_id FROM account_deletions)
+`
+
+func (q *Queries) CountRankedPlayers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countRankedPlayers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSeasonResults = `-- name: CountSeasonResults :one
+SELECT COUNT(*) FROM season_results
+JOIN players ON players.id = season_results.player_id
+WHERE season_results.season_id = ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+`
+
+func (q *Queries) CountSeasonResults(ctx context.Context, seasonID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSeasonResults, seasonID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAccountDeletion = `-- name: CreateAccountDeletion :exec
+INSERT INTO account_deletions (
+    user_id, requested_at, delete_after
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreateAccountDeletionParams struct {
+	UserID      int64
+	RequestedAt int64
+	DeleteAfter int64
+}
+
+func (q *Queries) CreateAccountDeletion(ctx context.Context, arg CreateAccountDeletionParams) error {
+	_, err := q.db.ExecContext(ctx, createAccountDeletion, arg.UserID, arg.RequestedAt, arg.DeleteAfter)
+	return err
+}
+
+const createChatMessage = `-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    sender_player_id, sender_name, channel, arena, target, msg, sent_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateChatMessageParams struct {
+	SenderPlayerID int64  `json:"sender_player_id"`
+	SenderName     string `json:"sender_name"`
+	Channel        int64  `json:"channel"`
+	Arena          string `json:"arena"`
+	Target         string `json:"target"`
+	Msg            string `json:"msg"`
+	SentAt         int64  `json:"sent_at"`
+}
+
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) error {
+	_, err := q.db.ExecContext(ctx, createChatMessage,
+		arg.SenderPlayerID,
+		arg.SenderName,
+		arg.Channel,
+		arg.Arena,
+		arg.Target,
+		arg.Msg,
+		arg.SentAt,
+	)
+	return err
+}
+
+const createFriend = `-- name: CreateFriend :exec
+INSERT INTO friends (
+    player_id, friend_player_id, created_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (player_id, friend_player_id) DO NOTHING
+`
+
+type CreateFriendParams struct {
+	PlayerID       int64 `json:"player_id"`
+	FriendPlayerID int64 `json:"friend_player_id"`
+	CreatedAt      int64 `json:"created_at"`
+}
+
+func (q *Queries) CreateFriend(ctx context.Context, arg CreateFriendParams) error {
+	_, err := q.db.ExecContext(ctx, createFriend, arg.PlayerID, arg.FriendPlayerID, arg.CreatedAt)
+	return err
+}
+
+const createFriendRequest = `-- name: CreateFriendRequest :execrows
+INSERT INTO friend_requests (
+    requester_player_id, recipient_player_id, created_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (requester_player_id, recipient_player_id) DO NOTHING
+`
+
+type CreateFriendRequestParams struct {
+	RequesterPlayerID int64 `json:"requester_player_id"`
+	RecipientPlayerID int64 `json:"recipient_player_id"`
+	CreatedAt         int64 `json:"created_at"`
+}
+
+func (q *Queries) CreateFriendRequest(ctx context.Context, arg CreateFriendRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createFriendRequest, arg.RequesterPlayerID, arg.RecipientPlayerID, arg.CreatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createIpBan = `-- name: CreateIpBan :one
+INSERT INTO ip_bans (
+    ip_address, reason, issued_by, issued_at, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+RETURNING id, ip_address, reason, issued_by, issued_at, expires_at, revoked_at, revoked_by
+`
+
+type CreateIpBanParams struct {
+	IpAddress string
+	Reason    string
+	IssuedBy  string
+	IssuedAt  int64
+	ExpiresAt sql.NullInt64
+}
+
+func (q *Queries) CreateIpBan(ctx context.Context, arg CreateIpBanParams) (IpBan, error) {
+	row := q.db.QueryRowContext(ctx, createIpBan,
+		arg.IpAddress,
+		arg.Reason,
+		arg.IssuedBy,
+		arg.IssuedAt,
+		arg.ExpiresAt,
+	)
+	var i IpBan
+	err := row.Scan(
+		&i.ID,
+		&i.IpAddress,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.IssuedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+	)
+	return i, err
+}
+
+const createLoginHistory = `-- name: CreateLoginHistory :exec
+INSERT INTO login_history (
+    user_id, ip_address, logged_in_at
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreateLoginHistoryParams struct {
+	UserID     int64
+	IpAddress  string
+	LoggedInAt int64
+}
+
+func (q *Queries) CreateLoginHistory(ctx context.Context, arg CreateLoginHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createLoginHistory, arg.UserID, arg.IpAddress, arg.LoggedInAt)
+	return err
+}
+
+const createModerationLogEntry = `-- name: CreateModerationLogEntry :exec
+INSERT INTO moderation_log (
+    actor, action, target, details, created_at
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+`
+
+type CreateModerationLogEntryParams struct {
+	Actor     string
+	Action    string
+	Target    string
+	Details   string
+	CreatedAt int64
+}
+
+func (q *Queries) CreateModerationLogEntry(ctx context.Context, arg CreateModerationLogEntryParams) error {
+	_, err := q.db.ExecContext(ctx, createModerationLogEntry,
+		arg.Actor,
+		arg.Action,
+		arg.Target,
+		arg.Details,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
 INSERT INTO players (
     user_id, name, color
 ) VALUES (
-    ?, ?, ?
+    ?, ?, ?
+)
+RETURNING id, user_id, name, best_score, color
+`
+
+type CreatePlayerParams struct {
+	UserID int64
+	Name   string
+	Color  int64
+}
+
+func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error) {
+	row := q.db.QueryRowContext(ctx, createPlayer, arg.UserID, arg.Name, arg.Color)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+	)
+	return i, err
+}
+
+const createPlayerAchievement = `-- name: CreatePlayerAchievement :execrows
+INSERT INTO player_achievements (
+    player_id, achievement_id, unlocked_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (player_id, achievement_id) DO NOTHING
+`
+
+type CreatePlayerAchievementParams struct {
+	PlayerID      int64  `json:"player_id"`
+	AchievementID string `json:"achievement_id"`
+	UnlockedAt    int64  `json:"unlocked_at"`
+}
+
+func (q *Queries) CreatePlayerAchievement(ctx context.Context, arg CreatePlayerAchievementParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createPlayerAchievement, arg.PlayerID, arg.AchievementID, arg.UnlockedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createPlayerBlock = `-- name: CreatePlayerBlock :exec
+INSERT INTO player_blocks (
+    player_id, blocked_player_id, created_at
+) VALUES (
+    ?, ?, ?
+)
+ON CONFLICT (player_id, blocked_player_id) DO NOTHING
+`
+
+type CreatePlayerBlockParams struct {
+	PlayerID        int64 `json:"player_id"`
+	BlockedPlayerID int64 `json:"blocked_player_id"`
+	CreatedAt       int64 `json:"created_at"`
+}
+
+func (q *Queries) CreatePlayerBlock(ctx context.Context, arg CreatePlayerBlockParams) error {
+	_, err := q.db.ExecContext(ctx, createPlayerBlock, arg.PlayerID, arg.BlockedPlayerID, arg.CreatedAt)
+	return err
+}
+
+const createPlayerNameHistory = `-- name: CreatePlayerNameHistory :exec
+INSERT INTO player_name_history (
+    player_id, name, changed_at
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreatePlayerNameHistoryParams struct {
+	PlayerID  int64
+	Name      string
+	ChangedAt int64
+}
+
+func (q *Queries) CreatePlayerNameHistory(ctx context.Context, arg CreatePlayerNameHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createPlayerNameHistory, arg.PlayerID, arg.Name, arg.ChangedAt)
+	return err
+}
+
+const createPlayerReport = `-- name: CreatePlayerReport :one
+INSERT INTO player_reports (
+    reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?
+)
+RETURNING id, reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at, resolved_at, resolved_by, resolution
+`
+
+type CreatePlayerReportParams struct {
+	ReporterPlayerID int64  `json:"reporter_player_id"`
+	ReporterName     string `json:"reporter_name"`
+	ReportedPlayerID int64  `json:"reported_player_id"`
+	ReportedName     string `json:"reported_name"`
+	Category         int64  `json:"category"`
+	Details          string `json:"details"`
+	Context          string `json:"context"`
+	CreatedAt        int64  `json:"created_at"`
+}
+
+func (q *Queries) CreatePlayerReport(ctx context.Context, arg CreatePlayerReportParams) (PlayerReport, error) {
+	row := q.db.QueryRowContext(ctx, createPlayerReport,
+		arg.ReporterPlayerID,
+		arg.ReporterName,
+		arg.ReportedPlayerID,
+		arg.ReportedName,
+		arg.Category,
+		arg.Details,
+		arg.Context,
+		arg.CreatedAt,
+	)
+	var i PlayerReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterPlayerID,
+		&i.ReporterName,
+		&i.ReportedPlayerID,
+		&i.ReportedName,
+		&i.Category,
+		&i.Details,
+		&i.Context,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.ResolvedBy,
+		&i.Resolution,
+	)
+	return i, err
+}
+
+const createScoreHistory = `-- name: CreateScoreHistory :exec
+INSERT INTO score_history (
+    player_id, score, started_at, ended_at
+) VALUES (
+    ?, ?, ?, ?
+)
+`
+
+type CreateScoreHistoryParams struct {
+	PlayerID  int64 `json:"player_id"`
+	Score     int64 `json:"score"`
+	StartedAt int64 `json:"started_at"`
+	EndedAt   int64 `json:"ended_at"`
+}
+
+func (q *Queries) CreateScoreHistory(ctx context.Context, arg CreateScoreHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createScoreHistory,
+		arg.PlayerID,
+		arg.Score,
+		arg.StartedAt,
+		arg.EndedAt,
+	)
+	return err
+}
+
+const createSeason = `-- name: CreateSeason :one
+INSERT INTO seasons (
+    name, started_at
+) VALUES (
+    ?, ?
+)
+RETURNING id, name, started_at, ended_at
+`
+
+type CreateSeasonParams struct {
+	Name      string `json:"name"`
+	StartedAt int64  `json:"started_at"`
+}
+
+func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error) {
+	row := q.db.QueryRowContext(ctx, createSeason, arg.Name, arg.StartedAt)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const createSeasonResult = `-- name: CreateSeasonResult :exec
+INSERT INTO season_results (
+    season_id, player_id, name, score, rank
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+ON CONFLICT (season_id, player_id) DO UPDATE SET name = excluded.name, score = excluded.score, rank = excluded.rank
+`
+
+type CreateSeasonResultParams struct {
+	SeasonID int64  `json:"season_id"`
+	PlayerID int64  `json:"player_id"`
+	Name     string `json:"name"`
+	Score    int64  `json:"score"`
+	Rank     int64  `json:"rank"`
+}
+
+func (q *Queries) CreateSeasonResult(ctx context.Context, arg CreateSeasonResultParams) error {
+	_, err := q.db.ExecContext(ctx, createSeasonResult,
+		arg.SeasonID,
+		arg.PlayerID,
+		arg.Name,
+		arg.Score,
+		arg.Rank,
+	)
+	return err
+}
+
+const createSessionStats = `-- name: CreateSessionStats :exec
+INSERT INTO session_stats (
+    player_id, started_at, ended_at, spores_eaten, players_eaten, times_eaten, seconds_alive, max_mass, distance_traveled
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateSessionStatsParams struct {
+	PlayerID         int64   `json:"player_id"`
+	StartedAt        int64   `json:"started_at"`
+	EndedAt          int64   `json:"ended_at"`
+	SporesEaten      int64   `json:"spores_eaten"`
+	PlayersEaten     int64   `json:"players_eaten"`
+	TimesEaten       int64   `json:"times_eaten"`
+	SecondsAlive     int64   `json:"seconds_alive"`
+	MaxMass          int64   `json:"max_mass"`
+	DistanceTraveled float64 `json:"distance_traveled"`
+}
+
+func (q *Queries) CreateSessionStats(ctx context.Context, arg CreateSessionStatsParams) error {
+	_, err := q.db.ExecContext(ctx, createSessionStats,
+		arg.PlayerID,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.SporesEaten,
+		arg.PlayersEaten,
+		arg.TimesEaten,
+		arg.SecondsAlive,
+		arg.MaxMass,
+		arg.DistanceTraveled,
+	)
+	return err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (
+    username, password_hash
+) VALUES (
+    ?, ?
+)
+RETURNING id, username, password_hash
+`
+
+type CreateUserParams struct {
+	Username     string
+	PasswordHash string
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, createUser, arg.Username, arg.PasswordHash)
+	var i User
+	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	return i, err
+}
+
+const createUserSanction = `-- name: CreateUserSanction :one
+INSERT INTO user_sanctions (
+    user_id, kind, reason, issued_by, issued_at, expires_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+RETURNING id, user_id, kind, reason, issued_by, issued_at, expires_at, revoked_at, revoked_by
+`
+
+type CreateUserSanctionParams struct {
+	UserID    int64
+	Kind      string
+	Reason    string
+	IssuedBy  string
+	IssuedAt  int64
+	ExpiresAt sql.NullInt64
+}
+
+func (q *Queries) CreateUserSanction(ctx context.Context, arg CreateUserSanctionParams) (UserSanction, error) {
+	row := q.db.QueryRowContext(ctx, createUserSanction,
+		arg.UserID,
+		arg.Kind,
+		arg.Reason,
+		arg.IssuedBy,
+		arg.IssuedAt,
+		arg.ExpiresAt,
+	)
+	var i UserSanction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Kind,
+		&i.Reason,
+		&i.IssuedBy,
+		&i.IssuedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+	)
+	return i, err
+}
+
+const deleteAccountDeletion = `-- name: DeleteAccountDeletion :exec
+DELETE FROM account_deletions
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteAccountDeletion(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountDeletion, userID)
+	return err
+}
+
+const deleteChatMessagesByUserId = `-- name: DeleteChatMessagesByUserId :exec
+DELETE FROM chat_messages
+WHERE sender_player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeleteChatMessagesByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteChatMessagesByUserId, userID)
+	return err
+}
+
+const deleteFriend = `-- name: DeleteFriend :execrows
+DELETE FROM friends
+WHERE (player_id = ? AND friend_player_id = ?)
+OR (player_id = ? AND friend_player_id = ?)
+`
+
+type DeleteFriendParams struct {
+	PlayerID         int64 `json:"player_id"`
+	FriendPlayerID   int64 `json:"friend_player_id"`
+	PlayerID_2       int64 `json:"player_id_2"`
+	FriendPlayerID_2 int64 `json:"friend_player_id_2"`
+}
+
+func (q *Queries) DeleteFriend(ctx context.Context, arg DeleteFriendParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFriend,
+		arg.PlayerID,
+		arg.FriendPlayerID,
+		arg.PlayerID_2,
+		arg.FriendPlayerID_2,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFriendRequest = `-- name: DeleteFriendRequest :execrows
+DELETE FROM friend_requests
+WHERE requester_player_id = ? AND recipient_player_id = ?
+`
+
+type DeleteFriendRequestParams struct {
+	RequesterPlayerID int64 `json:"requester_player_id"`
+	RecipientPlayerID int64 `json:"recipient_player_id"`
+}
+
+func (q *Queries) DeleteFriendRequest(ctx context.Context, arg DeleteFriendRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteFriendRequest, arg.RequesterPlayerID, arg.RecipientPlayerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFriendRequestsByUserId = `-- name: DeleteFriendRequestsByUserId :exec
+DELETE FROM friend_requests
+WHERE requester_player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR recipient_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type DeleteFriendRequestsByUserIdParams struct {
+	UserID   int64 `json:"user_id"`
+	UserID_2 int64 `json:"user_id_2"`
+}
+
+func (q *Queries) DeleteFriendRequestsByUserId(ctx context.Context, arg DeleteFriendRequestsByUserIdParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFriendRequestsByUserId, arg.UserID, arg.UserID_2)
+	return err
+}
+
+const deleteFriendsByUserId = `-- name: DeleteFriendsByUserId :exec
+DELETE FROM friends
+WHERE player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR friend_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type DeleteFriendsByUserIdParams struct {
+	UserID   int64 `json:"user_id"`
+	UserID_2 int64 `json:"user_id_2"`
+}
+
+func (q *Queries) DeleteFriendsByUserId(ctx context.Context, arg DeleteFriendsByUserIdParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFriendsByUserId, arg.UserID, arg.UserID_2)
+	return err
+}
+
+const deleteLoginHistoryByUserId = `-- name: DeleteLoginHistoryByUserId :exec
+DELETE FROM login_history
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteLoginHistoryByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteLoginHistoryByUserId, userID)
+	return err
+}
+
+const deletePlayerAchievementsByUserId = `-- name: DeletePlayerAchievementsByUserId :exec
+DELETE FROM player_achievements
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeletePlayerAchievementsByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerAchievementsByUserId, userID)
+	return err
+}
+
+const deletePlayerBlock = `-- name: DeletePlayerBlock :execrows
+DELETE FROM player_blocks
+WHERE player_id = ? AND blocked_player_id = ?
+`
+
+type DeletePlayerBlockParams struct {
+	PlayerID        int64 `json:"player_id"`
+	BlockedPlayerID int64 `json:"blocked_player_id"`
+}
+
+func (q *Queries) DeletePlayerBlock(ctx context.Context, arg DeletePlayerBlockParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePlayerBlock, arg.PlayerID, arg.BlockedPlayerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePlayerBlocksByUserId = `-- name: DeletePlayerBlocksByUserId :exec
+DELETE FROM player_blocks
+WHERE player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR blocked_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type DeletePlayerBlocksByUserIdParams struct {
+	UserID   int64 `json:"user_id"`
+	UserID_2 int64 `json:"user_id_2"`
+}
+
+func (q *Queries) DeletePlayerBlocksByUserId(ctx context.Context, arg DeletePlayerBlocksByUserIdParams) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerBlocksByUserId, arg.UserID, arg.UserID_2)
+	return err
+}
+
+const deletePlayerByUserId = `-- name: DeletePlayerByUserId :exec
+DELETE FROM players
+WHERE user_id = ?
+`
+
+func (q *Queries) DeletePlayerByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerByUserId, userID)
+	return err
+}
+
+const deletePlayerKillsByUserId = `-- name: DeletePlayerKillsByUserId :exec
+DELETE FROM player_kills
+WHERE killer_player_id IN (SELECT id FROM players WHERE user_id = ?)
+OR victim_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type DeletePlayerKillsByUserIdParams struct {
+	UserID   int64 `json:"user_id"`
+	UserID_2 int64 `json:"user_id_2"`
+}
+
+func (q *Queries) DeletePlayerKillsByUserId(ctx context.Context, arg DeletePlayerKillsByUserIdParams) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerKillsByUserId, arg.UserID, arg.UserID_2)
+	return err
+}
+
+const deletePlayerNameHistoryByUserId = `-- name: DeletePlayerNameHistoryByUserId :exec
+DELETE FROM player_name_history
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeletePlayerNameHistoryByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerNameHistoryByUserId, userID)
+	return err
+}
+
+const deletePlayerProgressByUserId = `-- name: DeletePlayerProgressByUserId :exec
+DELETE FROM player_progress
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeletePlayerProgressByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerProgressByUserId, userID)
+	return err
+}
+
+const deleteScoreHistoryByUserId = `-- name: DeleteScoreHistoryByUserId :exec
+DELETE FROM score_history
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeleteScoreHistoryByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteScoreHistoryByUserId, userID)
+	return err
+}
+
+const deleteSeasonResultsByUserId = `-- name: DeleteSeasonResultsByUserId :exec
+DELETE FROM season_results
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeleteSeasonResultsByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonResultsByUserId, userID)
+	return err
+}
+
+const deleteSessionStatsByUserId = `-- name: DeleteSessionStatsByUserId :exec
+DELETE FROM session_stats
+WHERE player_id IN (
+    SELECT id FROM players WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeleteSessionStatsByUserId(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSessionStatsByUserId, userID)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	return err
+}
+
+const deleteUserRole = `-- name: DeleteUserRole :exec
+DELETE FROM user_roles
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserRole(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUserRole, userID)
+	return err
+}
+
+const endSeason = `-- name: EndSeason :execrows
+UPDATE seasons
+SET ended_at = ?
+WHERE id = ? AND ended_at IS NULL
+`
+
+type EndSeasonParams struct {
+	EndedAt sql.NullInt64 `json:"ended_at"`
+	ID      int64         `json:"id"`
+}
+
+func (q *Queries) EndSeason(ctx context.Context, arg EndSeasonParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, endSeason, arg.EndedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getAccountDeletion = `-- name: GetAccountDeletion :one
+SELECT user_id, requested_at, delete_after FROM account_deletions
+WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetAccountDeletion(ctx context.Context, userID int64) (AccountDeletion, error) {
+	row := q.db.QueryRowContext(ctx, getAccountDeletion, userID)
+	var i AccountDeletion
+	err := row.Scan(&i.UserID, &i.RequestedAt, &i.DeleteAfter)
+	return i, err
+}
+
+const getChatMessagesByPlayerId = `-- name: GetChatMessagesByPlayerId :many
+SELECT id, sender_player_id, sender_name, channel, arena, target, msg, sent_at FROM chat_messages
+WHERE sender_player_id = ?
+ORDER BY sent_at, id
+`
+
+func (q *Queries) GetChatMessagesByPlayerId(ctx context.Context, senderPlayerID int64) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getChatMessagesByPlayerId, senderPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderPlayerID,
+			&i.SenderName,
+			&i.Channel,
+			&i.Arena,
+			&i.Target,
+			&i.Msg,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrentSeason = `-- name: GetCurrentSeason :one
+SELECT id, name, started_at, ended_at FROM seasons
+WHERE ended_at IS NULL
+ORDER BY started_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetCurrentSeason(ctx context.Context) (Season, error) {
+	row := q.db.QueryRowContext(ctx, getCurrentSeason)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const getDueAccountDeletions = `-- name: GetDueAccountDeletions :many
+SELECT user_id FROM account_deletions
+WHERE delete_after <= ?
+`
+
+func (q *Queries) GetDueAccountDeletions(ctx context.Context, deleteAfter int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getDueAccountDeletions, deleteAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var user_id int64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFriends = `-- name: GetFriends :many
+SELECT players.id, players.name FROM friends
+JOIN players ON players.id = friends.friend_player_id
+WHERE friends.player_id = ?
+ORDER BY players.name COLLATE NOCASE
+`
+
+type GetFriendsRow struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) GetFriends(ctx context.Context, playerID int64) ([]GetFriendsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFriends, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFriendsRow
+	for rows.Next() {
+		var i GetFriendsRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIncomingFriendRequests = `-- name: GetIncomingFriendRequests :many
+SELECT players.id, players.name FROM friend_requests
+JOIN players ON players.id = friend_requests.requester_player_id
+WHERE friend_requests.recipient_player_id = ?
+ORDER BY friend_requests.created_at
+`
+
+type GetIncomingFriendRequestsRow struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) GetIncomingFriendRequests(ctx context.Context, recipientPlayerID int64) ([]GetIncomingFriendRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getIncomingFriendRequests, recipientPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetIncomingFriendRequestsRow
+	for rows.Next() {
+		var i GetIncomingFriendRequestsRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIpBans = `-- name: GetIpBans :many
+SELECT id, ip_address, reason, issued_by, issued_at, expires_at, revoked_at, revoked_by FROM ip_bans
+WHERE ip_address = ? AND revoked_at IS NULL
+`
+
+func (q *Queries) GetIpBans(ctx context.Context, ipAddress string) ([]IpBan, error) {
+	rows, err := q.db.QueryContext(ctx, getIpBans, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []IpBan
+	for rows.Next() {
+		var i IpBan
+		if err := rows.Scan(
+			&i.ID,
+			&i.IpAddress,
+			&i.Reason,
+			&i.IssuedBy,
+			&i.IssuedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.RevokedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLastLoginIp = `-- name: GetLastLoginIp :one
+SELECT ip_address FROM login_history
+WHERE user_id = ?
+ORDER BY logged_in_at DESC, id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLastLoginIp(ctx context.Context, userID int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getLastLoginIp, userID)
+	var ip_address string
+	err := row.Scan(&ip_address)
+	return ip_address, err
+}
+
+const getLastPlayerNameChange = `-- name: GetLastPlayerNameChange :one
+SELECT changed_at FROM player_name_history
+WHERE player_id = ?
+ORDER BY changed_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLastPlayerNameChange(ctx context.Context, playerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getLastPlayerNameChange, playerID)
+	var changed_at int64
+	err := row.Scan(&changed_at)
+	return changed_at, err
+}
+
+const getLifetimeStats = `-- name: GetLifetimeStats :one
+SELECT
+    COUNT(*) AS sessions,
+    CAST(COALESCE(SUM(spores_eaten), 0) AS INTEGER) AS spores_eaten,
+    CAST(COALESCE(SUM(players_eaten), 0) AS INTEGER) AS players_eaten,
+    CAST(COALESCE(SUM(times_eaten), 0) AS INTEGER) AS times_eaten,
+    CAST(COALESCE(SUM(seconds_alive), 0) AS INTEGER) AS seconds_alive,
+    CAST(COALESCE(MAX(max_mass), 0) AS INTEGER) AS max_mass,
+    CAST(COALESCE(SUM(distance_traveled), 0) AS REAL) AS distance_traveled
+FROM session_stats
+WHERE player_id = ?
+`
+
+type GetLifetimeStatsRow struct {
+	Sessions         int64   `json:"sessions"`
+	SporesEaten      int64   `json:"spores_eaten"`
+	PlayersEaten     int64   `json:"players_eaten"`
+	TimesEaten       int64   `json:"times_eaten"`
+	SecondsAlive     int64   `json:"seconds_alive"`
+	MaxMass          int64   `json:"max_mass"`
+	DistanceTraveled float64 `json:"distance_traveled"`
+}
+
+func (q *Queries) GetLifetimeStats(ctx context.Context, playerID int64) (GetLifetimeStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getLifetimeStats, playerID)
+	var i GetLifetimeStatsRow
+	err := row.Scan(
+		&i.Sessions,
+		&i.SporesEaten,
+		&i.PlayersEaten,
+		&i.TimesEaten,
+		&i.SecondsAlive,
+		&i.MaxMass,
+		&i.DistanceTraveled,
+	)
+	return i, err
+}
+
+const getLoginHistory = `-- name: GetLoginHistory :many
+SELECT id, user_id, ip_address, logged_in_at FROM login_history
+WHERE user_id = ?
+ORDER BY logged_in_at
+`
+
+func (q *Queries) GetLoginHistory(ctx context.Context, userID int64) ([]LoginHistory, error) {
+	rows, err := q.db.QueryContext(ctx, getLoginHistory, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LoginHistory
+	for rows.Next() {
+		var i LoginHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.IpAddress,
+			&i.LoggedInAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getModerationLog = `-- name: GetModerationLog :many
+SELECT id, actor, action, target, details, created_at FROM moderation_log
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`
+
+func (q *Queries) GetModerationLog(ctx context.Context, limit int64) ([]ModerationLog, error) {
+	rows, err := q.db.QueryContext(ctx, getModerationLog, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ModerationLog
+	for rows.Next() {
+		var i ModerationLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Actor,
+			&i.Action,
+			&i.Target,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenPlayerReports = `-- name: GetOpenPlayerReports :many
+SELECT id, reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at, resolved_at, resolved_by, resolution FROM player_reports
+WHERE resolved_at IS NULL
+ORDER BY created_at, id
+LIMIT ?
+`
+
+func (q *Queries) GetOpenPlayerReports(ctx context.Context, limit int64) ([]PlayerReport, error) {
+	rows, err := q.db.QueryContext(ctx, getOpenPlayerReports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerReport
+	for rows.Next() {
+		var i PlayerReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterPlayerID,
+			&i.ReporterName,
+			&i.ReportedPlayerID,
+			&i.ReportedName,
+			&i.Category,
+			&i.Details,
+			&i.Context,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.ResolvedBy,
+			&i.Resolution,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOutgoingFriendRequests = `-- name: GetOutgoingFriendRequests :many
+SELECT players.id, players.name FROM friend_requests
+JOIN players ON players.id = friend_requests.recipient_player_id
+WHERE friend_requests.requester_player_id = ?
+ORDER BY friend_requests.created_at
+`
+
+type GetOutgoingFriendRequestsRow struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) GetOutgoingFriendRequests(ctx context.Context, requesterPlayerID int64) ([]GetOutgoingFriendRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOutgoingFriendRequests, requesterPlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOutgoingFriendRequestsRow
+	for rows.Next() {
+		var i GetOutgoingFriendRequestsRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerAchievements = `-- name: GetPlayerAchievements :many
+SELECT player_id, achievement_id, unlocked_at FROM player_achievements
+WHERE player_id = ?
+ORDER BY unlocked_at, achievement_id
+`
+
+func (q *Queries) GetPlayerAchievements(ctx context.Context, playerID int64) ([]PlayerAchievement, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerAchievements, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerAchievement
+	for rows.Next() {
+		var i PlayerAchievement
+		if err := rows.Scan(&i.PlayerID, &i.AchievementID, &i.UnlockedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerBestScoreBetween = `-- name: GetPlayerBestScoreBetween :one
+SELECT CAST(MAX(score) AS INTEGER) AS best_score FROM score_history
+WHERE player_id = ? AND ended_at >= ? AND ended_at < ?
+GROUP BY player_id
+`
+
+type GetPlayerBestScoreBetweenParams struct {
+	PlayerID  int64 `json:"player_id"`
+	EndedAt   int64 `json:"ended_at"`
+	EndedAt_2 int64 `json:"ended_at_2"`
+}
+
+func (q *Queries) GetPlayerBestScoreBetween(ctx context.Context, arg GetPlayerBestScoreBetweenParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerBestScoreBetween, arg.PlayerID, arg.EndedAt, arg.EndedAt_2)
+	var best_score int64
+	err := row.Scan(&best_score)
+	return best_score, err
+}
+
+const getPlayerBlocks = `-- name: GetPlayerBlocks :many
+SELECT players.id, players.name FROM player_blocks
+JOIN players ON players.id = player_blocks.blocked_player_id
+WHERE player_blocks.player_id = ?
+ORDER BY players.name COLLATE NOCASE
+`
+
+type GetPlayerBlocksRow struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) GetPlayerBlocks(ctx context.Context, playerID int64) ([]GetPlayerBlocksRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerBlocks, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerBlocksRow
+	for rows.Next() {
+		var i GetPlayerBlocksRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerByExactName = `-- name: GetPlayerByExactName :one
+SELECT id, user_id, name, best_score, color FROM players
+WHERE name = ? COLLATE NOCASE
+LIMIT 1
+`
+
+func (q *Queries) GetPlayerByExactName(ctx context.Context, name string) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerByExactName, name)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+	)
+	return i, err
+}
+
+const getPlayerById = `-- name: GetPlayerById :one
+SELECT id, user_id, name, best_score, color FROM players
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerById(ctx context.Context, id int64) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerById, id)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+	)
+	return i, err
+}
+
+const getPlayerByUserId = `-- name: GetPlayerByUserId :one
+SELECT id, user_id, name, best_score, color FROM players
+WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerByUserId(ctx context.Context, userID int64) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerByUserId, userID)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+	)
+	return i, err
+}
+
+const getPlayerNameHistory = `-- name: GetPlayerNameHistory :many
+SELECT id, player_id, name, changed_at FROM player_name_history
+WHERE player_id = ?
+ORDER BY changed_at
+`
+
+func (q *Queries) GetPlayerNameHistory(ctx context.Context, playerID int64) ([]PlayerNameHistory, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerNameHistory, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerNameHistory
+	for rows.Next() {
+		var i PlayerNameHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.Name,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerProgress = `-- name: GetPlayerProgress :one
+SELECT player_id, xp, badge FROM player_progress
+WHERE player_id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerProgress(ctx context.Context, playerID int64) (PlayerProgress, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerProgress, playerID)
+	var i PlayerProgress
+	err := row.Scan(&i.PlayerID, &i.Xp, &i.Badge)
+	return i, err
+}
+
+const getPlayerRank = `-- name: GetPlayerRank :one
+SELECT COUNT(*) + 1 as "rank" FROM players
+WHERE user_id NOT IN (SELECT user_id FROM account_deletions)
+AND best_score > (
+    SELECT best_score FROM players p2
+    WHERE p2.id = ?
+)
+`
+
+func (q *Queries) GetPlayerRank(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerRank, id)
+	var rank int64
+	err := row.Scan(&rank)
+	return rank, err
+}
+
+const getPlayerReport = `-- name: GetPlayerReport :one
+SELECT id, reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at, resolved_at, resolved_by, resolution FROM player_reports
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerReport(ctx context.Context, id int64) (PlayerReport, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerReport, id)
+	var i PlayerReport
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterPlayerID,
+		&i.ReporterName,
+		&i.ReportedPlayerID,
+		&i.ReportedName,
+		&i.Category,
+		&i.Details,
+		&i.Context,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.ResolvedBy,
+		&i.Resolution,
+	)
+	return i, err
+}
+
+const getPlayerReports = `-- name: GetPlayerReports :many
+SELECT id, reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at, resolved_at, resolved_by, resolution FROM player_reports
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`
+
+func (q *Queries) GetPlayerReports(ctx context.Context, limit int64) ([]PlayerReport, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerReports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerReport
+	for rows.Next() {
+		var i PlayerReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterPlayerID,
+			&i.ReporterName,
+			&i.ReportedPlayerID,
+			&i.ReportedName,
+			&i.Category,
+			&i.Details,
+			&i.Context,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.ResolvedBy,
+			&i.Resolution,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerReportsInvolvingUserId = `-- name: GetPlayerReportsInvolvingUserId :many
+SELECT id, reporter_player_id, reporter_name, reported_player_id, reported_name, category, details, context, created_at, resolved_at, resolved_by, resolution FROM player_reports
+WHERE reporter_player_id IN (SELECT id FROM players WHERE user_id = ?)
+   OR reported_player_id IN (SELECT id FROM players WHERE user_id = ?)
+`
+
+type GetPlayerReportsInvolvingUserIdParams struct {
+	UserID   int64 `json:"user_id"`
+	UserID_2 int64 `json:"user_id_2"`
+}
+
+func (q *Queries) GetPlayerReportsInvolvingUserId(ctx context.Context, arg GetPlayerReportsInvolvingUserIdParams) ([]PlayerReport, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerReportsInvolvingUserId, arg.UserID, arg.UserID_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerReport
+	for rows.Next() {
+		var i PlayerReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterPlayerID,
+			&i.ReporterName,
+			&i.ReportedPlayerID,
+			&i.ReportedName,
+			&i.Category,
+			&i.Details,
+			&i.Context,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.ResolvedBy,
+			&i.Resolution,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRankedPlayerByExactName = `-- name: GetRankedPlayerByExactName :one
+SELECT id, user_id, name, best_score, color FROM players
+WHERE name = ? COLLATE NOCASE
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+LIMIT 1
+`
+
+func (q *Queries) GetRankedPlayerByExactName(ctx context.Context, name string) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getRankedPlayerByExactName, name)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+	)
+	return i, err
+}
+
+const getRecentPublicChatMessages = `-- name: GetRecentPublicChatMessages :many
+SELECT id, sender_player_id, sender_name, channel, arena, target, msg, sent_at FROM chat_messages
+WHERE channel = ? OR (channel = ? AND arena = ?)
+ORDER BY sent_at DESC, id DESC
+LIMIT ?
+`
+
+type GetRecentPublicChatMessagesParams struct {
+	Channel   int64  `json:"channel"`
+	Channel_2 int64  `json:"channel_2"`
+	Arena     string `json:"arena"`
+	Limit     int64  `json:"limit"`
+}
+
+func (q *Queries) GetRecentPublicChatMessages(ctx context.Context, arg GetRecentPublicChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentPublicChatMessages,
+		arg.Channel,
+		arg.Channel_2,
+		arg.Arena,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderPlayerID,
+			&i.SenderName,
+			&i.Channel,
+			&i.Arena,
+			&i.Target,
+			&i.Msg,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScoreHistoryByPlayerId = `-- name: GetScoreHistoryByPlayerId :many
+SELECT id, player_id, score, started_at, ended_at FROM score_history
+WHERE player_id = ?
+ORDER BY ended_at, id
+`
+
+func (q *Queries) GetScoreHistoryByPlayerId(ctx context.Context, playerID int64) ([]ScoreHistory, error) {
+	rows, err := q.db.QueryContext(ctx, getScoreHistoryByPlayerId, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScoreHistory
+	for rows.Next() {
+		var i ScoreHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.Score,
+			&i.StartedAt,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonByName = `-- name: GetSeasonByName :one
+SELECT id, name, started_at, ended_at FROM seasons
+WHERE name = ? COLLATE NOCASE
+LIMIT 1
+`
+
+func (q *Queries) GetSeasonByName(ctx context.Context, name string) (Season, error) {
+	row := q.db.QueryRowContext(ctx, getSeasonByName, name)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const getSeasonResult = `-- name: GetSeasonResult :one
+SELECT season_id, player_id, name, score, rank FROM season_results
+WHERE season_id = ? AND player_id = ?
+LIMIT 1
+`
+
+type GetSeasonResultParams struct {
+	SeasonID int64 `json:"season_id"`
+	PlayerID int64 `json:"player_id"`
+}
+
+func (q *Queries) GetSeasonResult(ctx context.Context, arg GetSeasonResultParams) (SeasonResult, error) {
+	row := q.db.QueryRowContext(ctx, getSeasonResult, arg.SeasonID, arg.PlayerID)
+	var i SeasonResult
+	err := row.Scan(
+		&i.SeasonID,
+		&i.PlayerID,
+		&i.Name,
+		&i.Score,
+		&i.Rank,
+	)
+	return i, err
+}
+
+const getSeasonResults = `-- name: GetSeasonResults :many
+SELECT season_results.season_id, season_results.player_id, season_results.name, season_results.score, season_results.rank FROM season_results
+JOIN players ON players.id = season_results.player_id
+WHERE season_results.season_id = ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+ORDER BY season_results.rank, season_results.name COLLATE NOCASE
+LIMIT ?
+OFFSET ?
+`
+
+type GetSeasonResultsParams struct {
+	SeasonID int64 `json:"season_id"`
+	Limit    int64 `json:"limit"`
+	Offset   int64 `json:"offset"`
+}
+
+func (q *Queries) GetSeasonResults(ctx context.Context, arg GetSeasonResultsParams) ([]SeasonResult, error) {
+	rows, err := q.db.QueryContext(ctx, getSeasonResults, arg.SeasonID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SeasonResult
+	for rows.Next() {
+		var i SeasonResult
+		if err := rows.Scan(
+			&i.SeasonID,
+			&i.PlayerID,
+			&i.Name,
+			&i.Score,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasons = `-- name: GetSeasons :many
+SELECT id, name, started_at, ended_at FROM seasons
+ORDER BY started_at DESC
+`
+
+func (q *Queries) GetSeasons(ctx context.Context) ([]Season, error) {
+	rows, err := q.db.QueryContext(ctx, getSeasons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Season
+	for rows.Next() {
+		var i Season
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.StartedAt,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessionStatsByPlayerId = `-- name: GetSessionStatsByPlayerId :many
+SELECT id, player_id, started_at, ended_at, spores_eaten, players_eaten, times_eaten, seconds_alive, max_mass, distance_traveled FROM session_stats
+WHERE player_id = ?
+ORDER BY started_at, id
+`
+
+func (q *Queries) GetSessionStatsByPlayerId(ctx context.Context, playerID int64) ([]SessionStat, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionStatsByPlayerId, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionStat
+	for rows.Next() {
+		var i SessionStat
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.SporesEaten,
+			&i.PlayersEaten,
+			&i.TimesEaten,
+			&i.SecondsAlive,
+			&i.MaxMass,
+			&i.DistanceTraveled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopKillers = `-- name: GetTopKillers :many
+SELECT players.name, player_kills.kills FROM player_kills
+JOIN players ON players.id = player_kills.killer_player_id
+WHERE player_kills.victim_player_id = ?
+ORDER BY player_kills.kills DESC, player_kills.last_killed_at DESC
+LIMIT ?
+`
+
+type GetTopKillersParams struct {
+	VictimPlayerID int64 `json:"victim_player_id"`
+	Limit          int64 `json:"limit"`
+}
+
+type GetTopKillersRow struct {
+	Name  string `json:"name"`
+	Kills int64  `json:"kills"`
+}
+
+func (q *Queries) GetTopKillers(ctx context.Context, arg GetTopKillersParams) ([]GetTopKillersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopKillers, arg.VictimPlayerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopKillersRow
+	for rows.Next() {
+		var i GetTopKillersRow
+		if err := rows.Scan(&i.Name, &i.Kills); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopScores = `-- name: GetTopScores :many
+SELECT name, best_score, CAST(RANK() OVER (ORDER BY best_score DESC) AS INTEGER) AS "rank"
+FROM players
+WHERE user_id NOT IN (SELECT user_id FROM account_deletions)
+ORDER BY best_score DESC, name COLLATE NOCASE
+LIMIT ?
+OFFSET ?
+`
+
+type GetTopScoresParams struct {
+	Limit  int64
+	Offset int64
+}
+
+type GetTopScoresRow struct {
+	Name      string
+	BestScore int64
+	Rank      int64
+}
+
+func (q *Queries) GetTopScores(ctx context.Context, arg GetTopScoresParams) ([]GetTopScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopScores, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopScoresRow
+	for rows.Next() {
+		var i GetTopScoresRow
+		if err := rows.Scan(&i.Name, &i.BestScore, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopScoresBetween = `-- name: GetTopScoresBetween :many
+SELECT players.id, players.name, CAST(MAX(score_history.score) AS INTEGER) AS best_score,
+    CAST(RANK() OVER (ORDER BY MAX(score_history.score) DESC) AS INTEGER) AS "rank"
+FROM score_history
+JOIN players ON players.id = score_history.player_id
+WHERE score_history.ended_at >= ? AND score_history.ended_at < ?
+AND players.user_id NOT IN (SELECT user_id FROM account_deletions)
+GROUP BY players.id
+ORDER BY best_score DESC, players.name COLLATE NOCASE
+LIMIT ?
+OFFSET ?
+`
+
+type GetTopScoresBetweenParams struct {
+	EndedAt   int64 `json:"ended_at"`
+	EndedAt_2 int64 `json:"ended_at_2"`
+	Limit     int64 `json:"limit"`
+	Offset    int64 `json:"offset"`
+}
+
+type GetTopScoresBetweenRow struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	BestScore int64  `json:"best_score"`
+	Rank      int64  `json:"rank"`
+}
+
+func (q *Queries) GetTopScoresBetween(ctx context.Context, arg GetTopScoresBetweenParams) ([]GetTopScoresBetweenRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopScoresBetween,
+		arg.EndedAt,
+		arg.EndedAt_2,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopScoresBetweenRow
+	for rows.Next() {
+		var i GetTopScoresBetweenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.BestScore,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTopVictims = `-- name: GetTopVictims :many
+SELECT players.name, player_kills.kills FROM player_kills
+JOIN players ON players.id = player_kills.victim_player_id
+WHERE player_kills.killer_player_id = ?
+ORDER BY player_kills.kills DESC, player_kills.last_killed_at DESC
+LIMIT ?
+`
+
+type GetTopVictimsParams struct {
+	KillerPlayerID int64 `json:"killer_player_id"`
+	Limit          int64 `json:"limit"`
+}
+
+type GetTopVictimsRow struct {
+	Name  string `json:"name"`
+	Kills int64  `json:"kills"`
+}
+
+func (q *Queries) GetTopVictims(ctx context.Context, arg GetTopVictimsParams) ([]GetTopVictimsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTopVictims, arg.KillerPlayerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopVictimsRow
+	for rows.Next() {
+		var i GetTopVictimsRow
+		if err := rows.Scan(&i.Name, &i.Kills); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserById = `-- name: GetUserById :one
+SELECT id, username, password_hash FROM users
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserById(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserById, id)
+	var i User
+	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	return i, err
+}
+
+const getUserByUsername = `-- name: GetUserByUsername :one
+SELECT id, username, password_hash FROM users
+WHERE username = ? LIMIT 1
+`
+
+func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
+	var i User
+	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	return i, err
+}
+
+const getUserRole = `-- name: GetUserRole :one
+SELECT role FROM user_roles
+WHERE user_id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserRole(ctx context.Context, userID int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserRole, userID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const getUserSanctions = `-- name: GetUserSanctions :many
+SELECT id, user_id, kind, reason, issued_by, issued_at, expires_at, revoked_at, revoked_by FROM user_sanctions
+WHERE user_id = ? AND kind = ? AND revoked_at IS NULL
+`
+
+type GetUserSanctionsParams struct {
+	UserID int64
+	Kind   string
+}
+
+func (q *Queries) GetUserSanctions(ctx context.Context, arg GetUserSanctionsParams) ([]UserSanction, error) {
+	rows, err := q.db.QueryContext(ctx, getUserSanctions, arg.UserID, arg.Kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSanction
+	for rows.Next() {
+		var i UserSanction
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Reason,
+			&i.IssuedBy,
+			&i.IssuedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.RevokedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isFriend = `-- name: IsFriend :one
+SELECT COUNT(*) FROM friends
+WHERE player_id = ? AND friend_player_id = ?
+`
+
+type IsFriendParams struct {
+	PlayerID       int64 `json:"player_id"`
+	FriendPlayerID int64 `json:"friend_player_id"`
+}
+
+func (q *Queries) IsFriend(ctx context.Context, arg IsFriendParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, isFriend, arg.PlayerID, arg.FriendPlayerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const isPlayerBlocked = `-- name: IsPlayerBlocked :one
+SELECT COUNT(*) FROM player_blocks
+WHERE player_id = ? AND blocked_player_id = ?
+`
+
+type IsPlayerBlockedParams struct {
+	PlayerID        int64 `json:"player_id"`
+	BlockedPlayerID int64 `json:"blocked_player_id"`
+}
+
+func (q *Queries) IsPlayerBlocked(ctx context.Context, arg IsPlayerBlockedParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, isPlayerBlocked, arg.PlayerID, arg.BlockedPlayerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const raisePlayerBestScore = `-- name: RaisePlayerBestScore :exec
+UPDATE players
+SET best_score = ?
+WHERE id = ? AND best_score < ?
+`
+
+type RaisePlayerBestScoreParams struct {
+	BestScore   int64
+	ID          int64
+	BestScore_2 int64
+}
+
+func (q *Queries) RaisePlayerBestScore(ctx context.Context, arg RaisePlayerBestScoreParams) error {
+	_, err := q.db.ExecContext(ctx, raisePlayerBestScore, arg.BestScore, arg.ID, arg.BestScore_2)
+	return err
+}
+
+const recordPlayerKill = `-- name: RecordPlayerKill :exec
+INSERT INTO player_kills (
+    killer_player_id, victim_player_id, kills, last_killed_at
+) VALUES (
+    ?, ?, 1, ?
 )
-RETURNING id, user_id, name, best_score, color
+ON CONFLICT (killer_player_id, victim_player_id) DO UPDATE SET kills = kills + 1, last_killed_at = excluded.last_killed_at
 `
 
-type CreatePlayerParams struct {
-	UserID int64
-	Name   string
-	Color  int64
+type RecordPlayerKillParams struct {
+	KillerPlayerID int64 `json:"killer_player_id"`
+	VictimPlayerID int64 `json:"victim_player_id"`
+	LastKilledAt   int64 `json:"last_killed_at"`
 }
 
-func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error) {
-	row := q.db.QueryRowContext(ctx, createPlayer, arg.UserID, arg.Name, arg.Color)
-	var i Player
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Name,
-		&i.BestScore,
-		&i.Color,
+func (q *Queries) RecordPlayerKill(ctx context.Context, arg RecordPlayerKillParams) error {
+	_, err := q.db.ExecContext(ctx, recordPlayerKill, arg.KillerPlayerID, arg.VictimPlayerID, arg.LastKilledAt)
+	return err
+}
+
+const resolvePlayerReport = `-- name: ResolvePlayerReport :execrows
+UPDATE player_reports
+SET resolved_at = ?, resolved_by = ?, resolution = ?
+WHERE id = ? AND resolved_at IS NULL
+`
+
+type ResolvePlayerReportParams struct {
+	ResolvedAt sql.NullInt64 `json:"resolved_at"`
+	ResolvedBy string        `json:"resolved_by"`
+	Resolution string        `json:"resolution"`
+	ID         int64         `json:"id"`
+}
+
+func (q *Queries) ResolvePlayerReport(ctx context.Context, arg ResolvePlayerReportParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, resolvePlayerReport,
+		arg.ResolvedAt,
+		arg.ResolvedBy,
+		arg.Resolution,
+		arg.ID,
 	)
-	return i, err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const createUser = `-- name: CreateUser :one
-INSERT INTO users (
-    username, password_hash
-) VALUES (
-    ?, ?
-)
-RETURNING id, username, password_hash
+const revokeIpBans = `-- name: RevokeIpBans :execrows
+UPDATE ip_bans
+SET revoked_at = ?, revoked_by = ?
+WHERE ip_address = ? AND revoked_at IS NULL
 `
 
-type CreateUserParams struct {
-	Username     string
-	PasswordHash string
+type RevokeIpBansParams struct {
+	RevokedAt sql.NullInt64
+	RevokedBy string
+	IpAddress string
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRowContext(ctx, createUser, arg.Username, arg.PasswordHash)
-	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
-	return i, err
+func (q *Queries) RevokeIpBans(ctx context.Context, arg RevokeIpBansParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeIpBans, arg.RevokedAt, arg.RevokedBy, arg.IpAddress)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const getPlayerByName = `-- name: GetPlayerByName :one
-SELECT id, user_id, name, best_score, color FROM players
-WHERE name LIKE ?
-LIMIT 1
+const revokeUserSanctions = `-- name: RevokeUserSanctions :execrows
+UPDATE user_sanctions
+SET revoked_at = ?, revoked_by = ?
+WHERE user_id = ? AND kind = ? AND revoked_at IS NULL
 `
 
-func (q *Queries) GetPlayerByName(ctx context.Context, name string) (Player, error) {
-	row := q.db.QueryRowContext(ctx, getPlayerByName, name)
-	var i Player
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Name,
-		&i.BestScore,
-		&i.Color,
+type RevokeUserSanctionsParams struct {
+	RevokedAt sql.NullInt64
+	RevokedBy string
+	UserID    int64
+	Kind      string
+}
+
+func (q *Queries) RevokeUserSanctions(ctx context.Context, arg RevokeUserSanctionsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeUserSanctions,
+		arg.RevokedAt,
+		arg.RevokedBy,
+		arg.UserID,
+		arg.Kind,
 	)
-	return i, err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const getPlayerByUserId = `-- name: GetPlayerByUserId :one
-SELECT id, user_id, name, best_score, color FROM players
-WHERE user_id = ? LIMIT 1
+const searchChatMessages = `-- name: SearchChatMessages :many
+SELECT id, sender_player_id, sender_name, channel, arena, target, msg, sent_at FROM chat_messages
+WHERE sent_at >= ? AND sent_at <= ?
+ORDER BY sent_at DESC, id DESC
+LIMIT ?
 `
 
-func (q *Queries) GetPlayerByUserId(ctx context.Context, userID int64) (Player, error) {
-	row := q.db.QueryRowContext(ctx, getPlayerByUserId, userID)
-	var i Player
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Name,
-		&i.BestScore,
-		&i.Color,
+type SearchChatMessagesParams struct {
+	SentAt   int64 `json:"sent_at"`
+	SentAt_2 int64 `json:"sent_at_2"`
+	Limit    int64 `json:"limit"`
+}
+
+func (q *Queries) SearchChatMessages(ctx context.Context, arg SearchChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, searchChatMessages,
+		arg.SentAt,
+		arg.SentAt_2,
+		arg.Limit,
 	)
-	return i, err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderPlayerID,
+			&i.SenderName,
+			&i.Channel,
+			&i.Arena,
+			&i.Target,
+			&i.Msg,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getPlayerRank = `-- name: GetPlayerRank :one
-SELECT COUNT(*) + 1 as "rank" FROM players
-WHERE best_score >= (
-    SELECT best_score FROM players p2
-    WHERE p2.id = ?
-)
+const searchChatMessagesByPlayer = `-- name: SearchChatMessagesByPlayer :many
+SELECT id, sender_player_id, sender_name, channel, arena, target, msg, sent_at FROM chat_messages
+WHERE sender_player_id = ? AND sent_at >= ? AND sent_at <= ?
+ORDER BY sent_at DESC, id DESC
+LIMIT ?
 `
 
-func (q *Queries) GetPlayerRank(ctx context.Context, id int64) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getPlayerRank, id)
-	var rank int64
-	err := row.Scan(&rank)
-	return rank, err
+type SearchChatMessagesByPlayerParams struct {
+	SenderPlayerID int64 `json:"sender_player_id"`
+	SentAt         int64 `json:"sent_at"`
+	SentAt_2       int64 `json:"sent_at_2"`
+	Limit          int64 `json:"limit"`
 }
 
-const getTopScores = `-- name: GetTopScores :many
-SELECT name, best_score
-FROM players
-ORDER BY best_score DESC
+func (q *Queries) SearchChatMessagesByPlayer(ctx context.Context, arg SearchChatMessagesByPlayerParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, searchChatMessagesByPlayer,
+		arg.SenderPlayerID,
+		arg.SentAt,
+		arg.SentAt_2,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderPlayerID,
+			&i.SenderName,
+			&i.Channel,
+			&i.Arena,
+			&i.Target,
+			&i.Msg,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchPlayersByName = `-- name: SearchPlayersByName :many
+SELECT id, user_id, name, best_score, color FROM players
+WHERE name LIKE ? ESCAPE '\'
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+ORDER BY name = ? COLLATE NOCASE DESC, name LIKE ? ESCAPE '\' DESC, name LIKE ? ESCAPE '\' DESC, best_score DESC
 LIMIT ?
-OFFSET ?
 `
 
-type GetTopScoresParams struct {
-	Limit  int64
-	Offset int64
+type SearchPlayersByNameParams struct {
+	Name   string `json:"name"`
+	Name_2 string `json:"name_2"`
+	Name_3 string `json:"name_3"`
+	Name_4 string `json:"name_4"`
+	Limit  int64  `json:"limit"`
 }
 
-type GetTopScoresRow struct {
-	Name      string
-	BestScore int64
+func (q *Queries) SearchPlayersByName(ctx context.Context, arg SearchPlayersByNameParams) ([]Player, error) {
+	rows, err := q.db.QueryContext(ctx, searchPlayersByName,
+		arg.Name,
+		arg.Name_2,
+		arg.Name_3,
+		arg.Name_4,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Player
+	for rows.Next() {
+		var i Player
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.BestScore,
+			&i.Color,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetTopScores(ctx context.Context, arg GetTopScoresParams) ([]GetTopScoresRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTopScores, arg.Limit, arg.Offset)
+const searchPlayersByNameBetween = `-- name: SearchPlayersByNameBetween :many
+SELECT id, user_id, name, best_score, color FROM players
+WHERE name LIKE ? ESCAPE '\'
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+AND id IN (SELECT player_id FROM score_history WHERE ended_at >= ? AND ended_at < ?)
+ORDER BY name = ? COLLATE NOCASE DESC, name LIKE ? ESCAPE '\' DESC, name LIKE ? ESCAPE '\' DESC, best_score DESC
+LIMIT ?
+`
+
+type SearchPlayersByNameBetweenParams struct {
+	Name      string `json:"name"`
+	EndedAt   int64  `json:"ended_at"`
+	EndedAt_2 int64  `json:"ended_at_2"`
+	Name_2    string `json:"name_2"`
+	Name_3    string `json:"name_3"`
+	Name_4    string `json:"name_4"`
+	Limit     int64  `json:"limit"`
+}
+
+func (q *Queries) SearchPlayersByNameBetween(ctx context.Context, arg SearchPlayersByNameBetweenParams) ([]Player, error) {
+	rows, err := q.db.QueryContext(ctx, searchPlayersByNameBetween,
+		arg.Name,
+		arg.EndedAt,
+		arg.EndedAt_2,
+		arg.Name_2,
+		arg.Name_3,
+		arg.Name_4,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetTopScoresRow
+	var items []Player
 	for rows.Next() {
-		var i GetTopScoresRow
-		if err := rows.Scan(&i.Name, &i.BestScore); err != nil {
+		var i Player
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.BestScore,
+			&i.Color,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -151,16 +2598,96 @@ func (q *Queries) GetTopScores(ctx context.Context, arg GetTopScoresParams) ([]G
 	return items, nil
 }
 
-const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, password_hash FROM users
-WHERE username = ? LIMIT 1
+const searchPlayersByNameInSeason = `-- name: SearchPlayersByNameInSeason :many
+SELECT id, user_id, name, best_score, color FROM players
+WHERE name LIKE ? ESCAPE '\'
+AND user_id NOT IN (SELECT user_id FROM account_deletions)
+AND id IN (SELECT player_id FROM season_results WHERE season_id = ?)
+ORDER BY name = ? COLLATE NOCASE DESC, name LIKE ? ESCAPE '\' DESC, name LIKE ? ESCAPE '\' DESC, best_score DESC
+LIMIT ?
 `
 
-func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
-	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
-	return i, err
+type SearchPlayersByNameInSeasonParams struct {
+	Name     string `json:"name"`
+	SeasonID int64  `json:"season_id"`
+	Name_2   string `json:"name_2"`
+	Name_3   string `json:"name_3"`
+	Name_4   string `json:"name_4"`
+	Limit    int64  `json:"limit"`
+}
+
+func (q *Queries) SearchPlayersByNameInSeason(ctx context.Context, arg SearchPlayersByNameInSeasonParams) ([]Player, error) {
+	rows, err := q.db.QueryContext(ctx, searchPlayersByNameInSeason,
+		arg.Name,
+		arg.SeasonID,
+		arg.Name_2,
+		arg.Name_3,
+		arg.Name_4,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Player
+	for rows.Next() {
+		var i Player
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.BestScore,
+			&i.Color,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setPlayerBadge = `-- name: SetPlayerBadge :exec
+INSERT INTO player_progress (
+    player_id, badge
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE SET badge = excluded.badge
+`
+
+type SetPlayerBadgeParams struct {
+	PlayerID int64  `json:"player_id"`
+	Badge    string `json:"badge"`
+}
+
+func (q *Queries) SetPlayerBadge(ctx context.Context, arg SetPlayerBadgeParams) error {
+	_, err := q.db.ExecContext(ctx, setPlayerBadge, arg.PlayerID, arg.Badge)
+	return err
+}
+
+const setUserRole = `-- name: SetUserRole :exec
+INSERT INTO user_roles (
+    user_id, role
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (user_id) DO UPDATE SET role = excluded.role
+`
+
+type SetUserRoleParams struct {
+	UserID int64
+	Role   string
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) error {
+	_, err := q.db.ExecContext(ctx, setUserRole, arg.UserID, arg.Role)
+	return err
 }
 
 const updatePlayerBestScore = `-- name: UpdatePlayerBestScore :exec
@@ -178,3 +2705,52 @@ func (q *Queries) UpdatePlayerBestScore(ctx context.Context, arg UpdatePlayerBes
 	_, err := q.db.ExecContext(ctx, updatePlayerBestScore, arg.BestScore, arg.ID)
 	return err
 }
+
+const updatePlayerColor = `-- name: UpdatePlayerColor :exec
+UPDATE players
+SET color = ?
+WHERE id = ?
+`
+
+type UpdatePlayerColorParams struct {
+	Color int64 `json:"color"`
+	ID    int64 `json:"id"`
+}
+
+func (q *Queries) UpdatePlayerColor(ctx context.Context, arg UpdatePlayerColorParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerColor, arg.Color, arg.ID)
+	return err
+}
+
+const updatePlayerProfile = `-- name: UpdatePlayerProfile :exec
+UPDATE players
+SET name = ?, color = ?
+WHERE id = ?
+`
+
+type UpdatePlayerProfileParams struct {
+	Name  string
+	Color int64
+	ID    int64
+}
+
+func (q *Queries) UpdatePlayerProfile(ctx context.Context, arg UpdatePlayerProfileParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerProfile, arg.Name, arg.Color, arg.ID)
+	return err
+}
+
+const updatePlayerReportContext = `-- name: UpdatePlayerReportContext :exec
+UPDATE player_reports
+SET context = ?
+WHERE id = ?
+`
+
+type UpdatePlayerReportContextParams struct {
+	Context string `json:"context"`
+	ID      int64  `json:"id"`
+}
+
+func (q *Queries) UpdatePlayerReportContext(ctx context.Context, arg UpdatePlayerReportContextParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerReportContext, arg.Context, arg.ID)
+	return err
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// How often the hub's main loop checks in while it's waiting for something to do
+	heartbeatInterval = time.Second
+
+	// How long the hub's main loop can go without checking in before it's considered stuck
+	heartbeatTimeout = 10 * time.Second
+
+	// How long readiness checks wait on the database
+	dbCheckTimeout = 2 * time.Second
+)
+
+// Keeps track of whether the hub's main loop is still getting through its work, and what it's doing if it isn't
+type hubHeartbeat struct {
+	// When the loop last checked in, in Unix nanoseconds, or 0 if it hasn't started yet
+	lastBeat atomic.Int64
+
+	// What the loop is handling right now, or nil if it's waiting for something to do
+	activity atomic.Pointer[hubActivity]
+}
+
+type hubActivity struct {
+	description string
+	startedAt   time.Time
+}
+
+func (b *hubHeartbeat) beat() {
+	b.lastBeat.Store(time.Now().UnixNano())
+}
+
+// Records that the loop has started handling something, returning a function to call once it's done
+func (b *hubHeartbeat) busy(description string) func() {
+	b.activity.Store(&hubActivity{description: description, startedAt: time.Now()})
+	return func() {
+		b.activity.Store(nil)
+		b.beat()
+	}
+}
+
+// How long it's been since the loop last checked in, and whether it ever has
+func (b *hubHeartbeat) age() (time.Duration, bool) {
+	lastBeat := b.lastBeat.Load()
+	if lastBeat == 0 {
+		return 0, false
+	}
+	return time.Since(time.Unix(0, lastBeat)), true
+}
+
+// Checks the loop has checked in recently, describing what it's stuck on if it hasn't
+func (b *hubHeartbeat) check() HealthCheck {
+	age, started := b.age()
+	if !started {
+		return HealthCheck{Name: "hub", Ok: false, Detail: "main loop hasn't started yet"}
+	}
+	if age <= heartbeatTimeout {
+		return HealthCheck{Name: "hub", Ok: true}
+	}
+
+	detail := fmt.Sprintf("main loop hasn't checked in for %s", age.Round(time.Second))
+	if activity := b.activity.Load(); activity != nil {
+		detail = fmt.Sprintf("main loop stuck for %s while %s", time.Since(activity.startedAt).Round(time.Second), activity.description)
+	}
+	return HealthCheck{Name: "hub", Ok: false, Detail: detail}
+}
+
+// Logs when the hub's main loop gets stuck, and when it gets going again
+func (h *Hub) watchHeartbeatLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	stuck := false
+	for range ticker.C {
+		check := h.heartbeat.check()
+		if !check.Ok && !stuck {
+			hubLogger.Errorf("Hub is unhealthy: %s", check.Detail)
+		} else if check.Ok && stuck {
+			hubLogger.Printf("Hub is healthy again")
+		}
+		stuck = !check.Ok
+	}
+}
+
+// The outcome of checking one thing the server depends on
+type HealthCheck struct {
+	Name   string `json:"name"`
+	Ok     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type HealthReport struct {
+	Ok     bool          `json:"ok"`
+	Checks []HealthCheck `json:"checks"`
+}
+
+func newHealthReport(checks ...HealthCheck) HealthReport {
+	report := HealthReport{Ok: true, Checks: checks}
+	for _, check := range checks {
+		report.Ok = report.Ok && check.Ok
+	}
+	return report
+}
+
+// Whether the server should be left running. The hub only counts against it once its main loop has started, so a slow
+// start-up isn't mistaken for a hang.
+func (h *Hub) Liveness() HealthReport {
+	check := h.heartbeat.check()
+	if _, started := h.heartbeat.age(); !started {
+		check = HealthCheck{Name: "hub", Ok: true, Detail: "starting"}
+	}
+	return newHealthReport(check)
+}
+
+// Whether the server is ready for players to connect
+func (h *Hub) Readiness(ctx context.Context) HealthReport {
+	ctx, cancel := context.WithTimeout(ctx, dbCheckTimeout)
+	defer cancel()
+
+	database := HealthCheck{Name: "database", Ok: true}
+	if err := h.dbPool.PingContext(ctx); err != nil {
+		database = HealthCheck{Name: "database", Ok: false, Detail: err.Error()}
+	}
+
+	schema := HealthCheck{Name: "schema", Ok: h.schemaReady.Load()}
+	if !schema.Ok {
+		schema.Detail = "database hasn't been initialized yet"
+	}
+
+	spores := HealthCheck{Name: "spores", Ok: h.sporesSeeded.Load()}
+	if !spores.Ok {
+		spores.Detail = "spores haven't been placed yet"
+	}
+
+	return newHealthReport(database, schema, h.heartbeat.check(), spores)
+}
+
+// Answers with 200 if the server should be left running, otherwise 503
+func (h *Hub) ServeHealthz(writer http.ResponseWriter, _ *http.Request) {
+	writeHealthReport(writer, h.Liveness())
+}
+
+// Answers with 200 if the server is ready for players, otherwise 503
+func (h *Hub) ServeReadyz(writer http.ResponseWriter, request *http.Request) {
+	writeHealthReport(writer, h.Readiness(request.Context()))
+}
+
+func writeHealthReport(writer http.ResponseWriter, report HealthReport) {
+	status := http.StatusOK
+	if !report.Ok {
+		status = http.StatusServiceUnavailable
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(report); err != nil {
+		hubLogger.Errorf("Error writing health report: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+var ErrSeasonExists = errors.New("a season with that name already exists")
+
+// The start (inclusive) and end (exclusive) of the daily, weekly or monthly hiscore period the given time falls in.
+// Periods follow the calendar in UTC, and weeks start on Monday.
+func HiscorePeriodWindow(period packets.HiscorePeriod, now time.Time) (time.Time, time.Time, error) {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch period {
+	case packets.HiscorePeriod_HISCORE_PERIOD_DAILY:
+		return today, today.AddDate(0, 0, 1), nil
+	case packets.HiscorePeriod_HISCORE_PERIOD_WEEKLY:
+		daysSinceMonday := (int(today.Weekday()) + 6) % 7
+		monday := today.AddDate(0, 0, -daysSinceMonday)
+		return monday, monday.AddDate(0, 0, 7), nil
+	case packets.HiscorePeriod_HISCORE_PERIOD_MONTHLY:
+		firstOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return firstOfMonth, firstOfMonth.AddDate(0, 1, 0), nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("hiscore period %v isn't a calendar period", period)
+	}
+}
+
+// The start (inclusive) and end (exclusive) of a season. A season that hasn't ended yet runs until just after now.
+func SeasonWindow(season db.Season, now time.Time) (time.Time, time.Time) {
+	end := now.Add(time.Second)
+	if season.EndedAt.Valid {
+		end = time.Unix(season.EndedAt.Int64, 0)
+	}
+	return time.Unix(season.StartedAt, 0), end
+}
+
+// Ends the current season, if there is one, archiving its final standings, and starts a new one with the given name
+func StartSeason(dbTx *DbTx, name string) (db.Season, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return db.Season{}, errors.New("season name can't be empty")
+	}
+
+	if _, err := dbTx.Queries.GetSeasonByName(dbTx.Ctx, name); err == nil {
+		return db.Season{}, ErrSeasonExists
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return db.Season{}, fmt.Errorf("error checking for an existing season: %w", err)
+	}
+
+	now := time.Now()
+	current, err := dbTx.Queries.GetCurrentSeason(dbTx.Ctx)
+	if err == nil {
+		if err := EndSeason(dbTx, current, now); err != nil {
+			return db.Season{}, err
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return db.Season{}, fmt.Errorf("error getting current season: %w", err)
+	}
+
+	return dbTx.Queries.CreateSeason(dbTx.Ctx, db.CreateSeasonParams{
+		Name:      name,
+		StartedAt: now.Unix(),
+	})
+}
+
+// Archives the final standings of the season, then marks it as ended. The score history it was worked out from is
+// kept, so the standings can always be rebuilt.
+func EndSeason(dbTx *DbTx, season db.Season, endedAt time.Time) error {
+	start, _ := SeasonWindow(season, endedAt)
+	standings, err := dbTx.Queries.GetTopScoresBetween(dbTx.Ctx, db.GetTopScoresBetweenParams{
+		EndedAt:   start.Unix(),
+		EndedAt_2: endedAt.Unix(),
+		Limit:     -1,
+		Offset:    0,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting final standings of season %s: %w", season.Name, err)
+	}
+
+	for _, standing := range standings {
+		err := dbTx.Queries.CreateSeasonResult(dbTx.Ctx, db.CreateSeasonResultParams{
+			SeasonID: season.ID,
+			PlayerID: standing.ID,
+			Name:     standing.Name,
+			Score:    standing.BestScore,
+			Rank:     standing.Rank,
+		})
+		if err != nil {
+			return fmt.Errorf("error archiving result of %s in season %s: %w", standing.Name, season.Name, err)
+		}
+	}
+
+	ended, err := dbTx.Queries.EndSeason(dbTx.Ctx, db.EndSeasonParams{
+		EndedAt: sql.NullInt64{Int64: endedAt.Unix(), Valid: true},
+		ID:      season.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("error ending season %s: %w", season.Name, err)
+	}
+	if ended == 0 {
+		return fmt.Errorf("season %s has already ended", season.Name)
+	}
+	return nil
+}
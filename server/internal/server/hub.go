@@ -4,39 +4,189 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"errors"
+	"fmt"
 	"log"
 	"math/rand/v2"
 	"net/http"
 	"path"
 	"server/internal/server/db"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
 	"server/internal/server/objects"
+	"server/internal/server/tracing"
 	"server/pkg/packets"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	_ "modernc.org/sqlite"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 )
 
+var hubLogger = logging.Subsystem("hub")
+
+// How many spores the world is kept topped up with, unless an admin changes it while the server is running
 const MaxSpores = 1000
 
+// The most spores an admin can ask for, since every client has to keep track of all of them
+const SporeTargetLimit = 10_000
+
+// What a deleted player is called in the records kept about them after their account is purged
+const DeletedPlayerName = "[deleted]"
+
+// There's only one game world for now, but chat is recorded against the arena it was sent in so that can change
+const MainArena = "main"
+
 //go:embed db/config/schema.sql
 var schemaGenSql string
 
 type DbTx struct {
 	Ctx     context.Context
 	Queries *db.Queries
+
+	// Where transactions are started from, and how the queries in them are traced
+	dbPool     *sql.DB
+	activeSpan func() *tracing.Span
 }
 
 func (h *Hub) NewDbTx() *DbTx {
 	return &DbTx{
 		Ctx:     context.Background(),
-		Queries: db.New(h.dbPool),
+		Queries: db.New(timedDb{db: h.dbPool}),
+		dbPool:  h.dbPool,
+	}
+}
+
+// Like NewDbTx, but every query is traced as a child of the span returned by activeSpan when it runs, if any
+func (h *Hub) NewTracedDbTx(activeSpan func() *tracing.Span) *DbTx {
+	return &DbTx{
+		Ctx:        context.Background(),
+		Queries:    db.New(timedDb{db: h.dbPool, activeSpan: activeSpan}),
+		dbPool:     h.dbPool,
+		activeSpan: activeSpan,
+	}
+}
+
+// Runs the queries made by fn in a single transaction, which is committed if fn succeeds and rolled back otherwise
+func (d *DbTx) InTransaction(fn func(queries *db.Queries) error) error {
+	if d.dbPool == nil {
+		return errors.New("no database to start a transaction on")
+	}
+
+	tx, err := d.dbPool.BeginTx(d.Ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(db.New(timedDb{db: tx, activeSpan: d.activeSpan})); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// A packet for every client except its sender to process
+type Broadcast struct {
+	*packets.Packet
+
+	// Where the broadcast fits into the trace of whatever caused it, if that's being traced
+	Span *tracing.Span
+}
+
+// What to do when someone logs into an account which is already logged in on another client
+type DuplicateLoginPolicy int
+
+const (
+	// Deny the new login, leaving the existing session alone
+	RejectDuplicateLogin DuplicateLoginPolicy = iota
+
+	// Kick the existing session and let the new login through
+	KickExistingSession
+)
+
+func ParseDuplicateLoginPolicy(policy string) (DuplicateLoginPolicy, error) {
+	switch strings.ToLower(policy) {
+	case "kick":
+		return KickExistingSession, nil
+	case "reject":
+		return RejectDuplicateLogin, nil
+	}
+	return RejectDuplicateLogin, fmt.Errorf("unknown duplicate login policy %q", policy)
+}
+
+func (p DuplicateLoginPolicy) String() string {
+	if p == KickExistingSession {
+		return "kick"
+	}
+	return "reject"
+}
+
+var ErrAccountInUse = errors.New("account is already logged in")
+
+// A thread-safe record of which client each logged in account belongs to
+type LoggedInAccounts struct {
+	clientIds map[int64]uint64
+	mux       sync.Mutex
+}
+
+func newLoggedInAccounts() *LoggedInAccounts {
+	return &LoggedInAccounts{
+		clientIds: make(map[int64]uint64),
+	}
+}
+
+// Claim the account for the client if nobody else has it. Otherwise, returns the ID of the client that does.
+func (a *LoggedInAccounts) claim(userId int64, clientId uint64) (uint64, bool) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	if existingId, exists := a.clientIds[userId]; exists && existingId != clientId {
+		return existingId, false
+	}
+	a.clientIds[userId] = clientId
+	return clientId, true
+}
+
+// Take the account from whoever has it and give it to the client. Returns the ID of the previous client, if any.
+func (a *LoggedInAccounts) steal(userId int64, clientId uint64) (uint64, bool) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	existingId, exists := a.clientIds[userId]
+	a.clientIds[userId] = clientId
+	return existingId, exists && existingId != clientId
+}
+
+// Release whichever account the client has claimed, if any
+func (a *LoggedInAccounts) release(clientId uint64) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	for userId, existingId := range a.clientIds {
+		if existingId == clientId {
+			delete(a.clientIds, userId)
+		}
 	}
 }
 
+// Get the ID of the client logged into the account, if any
+func (a *LoggedInAccounts) ClientId(userId int64) (uint64, bool) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	clientId, exists := a.clientIds[userId]
+	return clientId, exists
+}
+
 type SharedGameObjects struct {
 	// The ID of the player is the ID of the client that owns it
 	Players *objects.SharedCollection[*objects.Player]
 	Spores  *objects.SharedCollection[*objects.Spore]
+
+	// Recent consumptions between players, so reports can show what happened
+	Consumptions *objects.ConsumptionLog
 }
 
 // A structure for a state machine to process the client's messages
@@ -55,7 +205,9 @@ type ClientStateHandler interface {
 
 type ClientInterfacer interface {
 	Id() uint64
-	ProcessMessage(senderId uint64, message packets.Msg)
+
+	// Handle a message, as part of the trace the span belongs to if it's being traced
+	ProcessMessage(span *tracing.Span, senderId uint64, message packets.Msg)
 
 	// Sets the client's ID and anything else that needs to be initialized
 	Initialize(id uint64)
@@ -83,8 +235,33 @@ type ClientInterfacer interface {
 	// A reference to the database transaction context for this client
 	DbTx() *DbTx
 
+	// Record that this client is logged into the account, enforcing the hub's duplicate login policy.
+	// Returns ErrAccountInUse if the account is logged in elsewhere and the policy says to reject the login.
+	ClaimAccount(userId int64) error
+
+	// Record that this client is no longer logged into any account
+	ReleaseAccount()
+
+	// The ID of the user this client is logged in as, or 0 if not logged in
+	AccountId() int64
+
+	// The IP address the client is connecting from
+	IpAddress() string
+
+	// Tell the client why it's being disconnected, then close its connection
+	Kick(reason string)
+
 	SharedGameObjects() *SharedGameObjects
 
+	// The filter every chat message sent by this client must pass
+	ChatFilter() *ChatFilter
+
+	// Where this client's player reports what they get up to in the game, for their stats
+	Stats() *StatsAggregator
+
+	// The achievements this client's player can earn
+	Achievements() *Achievements
+
 	// Close the client's connections and cleanup
 	Close(reason string)
 }
@@ -94,7 +271,7 @@ type Hub struct {
 	Clients *objects.SharedCollection[ClientInterfacer]
 
 	// Packets in this channel will be processed by all connected clients except the sender
-	BroadcastChan chan *packets.Packet
+	BroadcastChan chan *Broadcast
 
 	// Clients in this channel will be registered to the hub
 	RegisterChan chan ClientInterfacer
@@ -106,6 +283,33 @@ type Hub struct {
 	dbPool *sql.DB
 
 	SharedGameObjects *SharedGameObjects
+
+	// The accounts currently logged in, so the same account can't be played from two clients at once
+	LoggedInAccounts *LoggedInAccounts
+
+	DuplicateLoginPolicy DuplicateLoginPolicy
+
+	// Guards the settings which can be changed while the server is running
+	settingsMux sync.RWMutex
+
+	// How many spores the world is kept topped up with
+	sporeTarget atomic.Int64
+
+	startedAt time.Time
+
+	// Whether the hub's main loop is keeping up, and how far it got starting up
+	heartbeat    hubHeartbeat
+	schemaReady  atomic.Bool
+	sporesSeeded atomic.Bool
+
+	// Rate limits and filters chat messages sent by every client
+	ChatFilter *ChatFilter
+
+	// Totals up what every player gets up to in the game
+	Stats *StatsAggregator
+
+	// The achievements every player can earn
+	Achievements *Achievements
 }
 
 func NewHub(dataDirPath string) *Hub {
@@ -114,55 +318,115 @@ func NewHub(dataDirPath string) *Hub {
 		log.Fatalf("Error opening database: %v", err)
 	}
 
-	return &Hub{
+	achievements, err := NewAchievements(DefaultAchievements)
+	if err != nil {
+		log.Fatalf("Error setting up default achievements: %v", err)
+	}
+
+	hub := &Hub{
 		Clients:        objects.NewSharedCollection[ClientInterfacer](),
-		BroadcastChan:  make(chan *packets.Packet),
+		BroadcastChan:  make(chan *Broadcast),
 		RegisterChan:   make(chan ClientInterfacer),
 		UnregisterChan: make(chan ClientInterfacer),
 		dbPool:         dbPool,
 		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
+			Players:      objects.NewSharedCollection[*objects.Player](),
+			Spores:       objects.NewSharedCollection[*objects.Spore](),
+			Consumptions: objects.NewConsumptionLog(1000),
 		},
+		LoggedInAccounts: newLoggedInAccounts(),
+		ChatFilter:       NewChatFilter(DefaultChatFilterConfig),
+		Achievements:     achievements,
+		startedAt:        time.Now(),
+	}
+	hub.Stats = NewStatsAggregator(hub.NewDbTx())
+	hub.sporeTarget.Store(MaxSpores)
+
+	return hub
+}
+
+// Create any tables missing from the database
+func InitializeDb(ctx context.Context, dbPool *sql.DB) error {
+	_, err := dbPool.ExecContext(ctx, schemaGenSql)
+	if IsUniqueConstraintError(err) {
+		return fmt.Errorf("some players have names which only differ by case, and need renaming before the server can start: %w", err)
 	}
+	return err
+}
+
+// Whether the error is from a query breaking a UNIQUE constraint, e.g. registering a name someone else already has
+func IsUniqueConstraintError(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
 }
 
 func (h *Hub) Run() {
-	log.Println("Initializing database...")
-	if _, err := h.dbPool.ExecContext(context.Background(), schemaGenSql); err != nil {
+	hubLogger.Println("Initializing database...")
+	if err := InitializeDb(context.Background(), h.dbPool); err != nil {
 		log.Fatalf("Error initializing database: %v", err)
 	}
+	h.schemaReady.Store(true)
 
-	log.Println("Placing spores...")
-	for i := 0; i < MaxSpores; i++ {
+	hubLogger.Println("Placing spores...")
+	for i := int64(0); i < h.SporeTarget(); i++ {
 		h.SharedGameObjects.Spores.Add(h.newSpore())
 	}
+	h.sporesSeeded.Store(true)
+
+	metrics.ConnectedClients.Set(func() float64 {
+		return float64(h.Clients.Len())
+	})
+	metrics.Spores.Set(func() float64 {
+		return float64(h.SharedGameObjects.Spores.Len())
+	})
+	metrics.HubHeartbeatAge.Set(func() float64 {
+		age, _ := h.heartbeat.age()
+		return age.Seconds()
+	})
 
 	go h.replenishSporesLoop(2 * time.Second)
+	go h.purgeDeletedAccountsLoop(time.Hour)
+	go h.broadcastLeaderboardLoop(LeaderboardInterval)
+	go h.Stats.Run()
+	go h.watchHeartbeatLoop(heartbeatInterval)
 
-	log.Println("Awaiting client registrations")
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	h.heartbeat.beat()
+
+	hubLogger.Println("Awaiting client registrations")
 	for {
 		select {
 		case client := <-h.RegisterChan:
+			done := h.heartbeat.busy("registering a client")
 			client.Initialize(h.Clients.Add(client))
+			done()
 		case client := <-h.UnregisterChan:
+			done := h.heartbeat.busy(fmt.Sprintf("unregistering client %d", client.Id()))
+			h.LoggedInAccounts.release(client.Id())
 			h.Clients.Remove(client.Id())
+			done()
 		case packet := <-h.BroadcastChan:
+			done := h.heartbeat.busy(fmt.Sprintf("broadcasting %s from client %d", metrics.MessageType(packet.Msg), packet.SenderId))
 			h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
 				if clientId != packet.SenderId {
-					client.ProcessMessage(packet.SenderId, packet.Msg)
+					client.ProcessMessage(packet.Span, packet.SenderId, packet.Msg)
 				}
 			})
+			packet.Span.End()
+			done()
+		case <-heartbeat.C:
+			h.heartbeat.beat()
 		}
 	}
 }
 
 func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request) (ClientInterfacer, error), writer http.ResponseWriter, request *http.Request) {
-	log.Println("New client connected from", request.RemoteAddr)
+	hubLogger.Println("New client connected from", request.RemoteAddr)
 	client, err := getNewClient(h, writer, request)
 
 	if err != nil {
-		log.Printf("Error obtaining client for new connection: %v", err)
+		hubLogger.Errorf("Error obtaining client for new connection: %v", err)
 		return
 	}
 
@@ -172,6 +436,65 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 	go client.ReadPump()
 }
 
+// Record that the client is logged into the account, enforcing the duplicate login policy
+func (h *Hub) ClaimAccount(userId int64, client ClientInterfacer) error {
+	h.settingsMux.RLock()
+	policy := h.DuplicateLoginPolicy
+	h.settingsMux.RUnlock()
+
+	switch policy {
+	case KickExistingSession:
+		if existingId, stolen := h.LoggedInAccounts.steal(userId, client.Id()); stolen {
+			hubLogger.Printf("Account %d logged in on client %d, kicking existing session on client %d", userId, client.Id(), existingId)
+			if existing, exists := h.Clients.Get(existingId); exists {
+				existing.Kick("Logged in from another location")
+			}
+		}
+	default:
+		if existingId, claimed := h.LoggedInAccounts.claim(userId, client.Id()); !claimed {
+			hubLogger.Printf("Account %d is already logged in on client %d, rejecting login from client %d", userId, existingId, client.Id())
+			return ErrAccountInUse
+		}
+	}
+	return nil
+}
+
+// Record that the client is no longer logged into any account
+func (h *Hub) ReleaseAccount(clientId uint64) {
+	h.LoggedInAccounts.release(clientId)
+}
+
+// Applies new settings while the server is running, without disturbing anyone who's already connected
+func (h *Hub) Reconfigure(policy DuplicateLoginPolicy, chatFilter ChatFilterConfig) {
+	h.settingsMux.Lock()
+	h.DuplicateLoginPolicy = policy
+	h.settingsMux.Unlock()
+
+	h.ChatFilter.Reconfigure(chatFilter)
+}
+
+// The settings which can be changed while the server is running, as they are now
+func (h *Hub) Settings() (DuplicateLoginPolicy, ChatFilterConfig) {
+	h.settingsMux.RLock()
+	defer h.settingsMux.RUnlock()
+	return h.DuplicateLoginPolicy, h.ChatFilter.Config()
+}
+
+// How many spores the world is kept topped up with
+func (h *Hub) SporeTarget() int64 {
+	return h.sporeTarget.Load()
+}
+
+// Changes how many spores the world is kept topped up with. Raising it fills the world up over the next few seconds,
+// but lowering it leaves any extra spores to be eaten rather than taking them away from under the players.
+func (h *Hub) SetSporeTarget(target int64) error {
+	if target < 0 || target > SporeTargetLimit {
+		return fmt.Errorf("spore target must be between 0 and %d", SporeTargetLimit)
+	}
+	h.sporeTarget.Store(target)
+	return nil
+}
+
 func (h *Hub) newSpore() *objects.Spore {
 	sporeRadius := max(10+rand.NormFloat64()*3, 5)
 	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
@@ -184,26 +507,155 @@ func (h *Hub) replenishSporesLoop(rate time.Duration) {
 
 	for range ticker.C {
 		sporesRemaining := h.SharedGameObjects.Spores.Len()
-		diff := MaxSpores - sporesRemaining
+		diff := int(h.SporeTarget()) - sporesRemaining
 
 		if diff <= 0 {
 			continue
 		}
 
-		log.Printf("%d spores remain - going to replenish %d spores", sporesRemaining, diff)
+		hubLogger.Debugf("%d spores remain - going to replenish %d spores", sporesRemaining, diff)
 
 		// Don't really want to spawn too many at a time, otherwise it can cause lag spikes
 		for i := 0; i < min(diff, 10); i++ {
 			spore := h.newSpore()
 			sporeId := h.SharedGameObjects.Spores.Add(spore)
 
-			h.BroadcastChan <- &packets.Packet{
+			h.BroadcastChan <- &Broadcast{Packet: &packets.Packet{
 				SenderId: 0,
 				Msg:      packets.NewSpore(sporeId, spore),
-			}
+			}}
 
 			// Sleep a little bit to avoid lag spikes
 			time.Sleep(50 * time.Millisecond)
 		}
 	}
 }
+
+func (h *Hub) purgeDeletedAccountsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	h.purgeDeletedAccounts()
+	for range ticker.C {
+		h.purgeDeletedAccounts()
+	}
+}
+
+// Hard deletes all accounts whose deletion grace period has expired
+func (h *Hub) purgeDeletedAccounts() {
+	ctx := context.Background()
+	userIds, err := db.New(h.dbPool).GetDueAccountDeletions(ctx, time.Now().Unix())
+	if err != nil {
+		hubLogger.Errorf("Error getting accounts due for deletion: %v", err)
+		return
+	}
+
+	for _, userId := range userIds {
+		if err := h.purgeAccount(ctx, userId); err != nil {
+			hubLogger.Errorf("Error deleting account %d: %v", userId, err)
+			continue
+		}
+		hubLogger.Printf("Deleted account %d", userId)
+	}
+}
+
+// Permanently removes everything stored about the user with the given ID, except what moderators need to keep: reports
+// filed by or against them are anonymised, and their sanctions are kept as a record of what they were punished for.
+// User IDs are never reused, so the sanctions can't end up applying to anyone else.
+func (h *Hub) purgeAccount(ctx context.Context, userId int64) error {
+	tx, err := h.dbPool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	queries := db.New(tx)
+
+	// This needs the user's name history, so goes before it's deleted
+	if err := anonymizePlayer(ctx, queries, userId); err != nil {
+		return err
+	}
+
+	if err := queries.DeletePlayerNameHistoryByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteChatMessagesByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteSessionStatsByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeletePlayerAchievementsByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeletePlayerProgressByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeletePlayerKillsByUserId(ctx, db.DeletePlayerKillsByUserIdParams{UserID: userId, UserID_2: userId}); err != nil {
+		return err
+	}
+	if err := queries.DeleteScoreHistoryByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteSeasonResultsByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteFriendsByUserId(ctx, db.DeleteFriendsByUserIdParams{UserID: userId, UserID_2: userId}); err != nil {
+		return err
+	}
+	if err := queries.DeleteFriendRequestsByUserId(ctx, db.DeleteFriendRequestsByUserIdParams{UserID: userId, UserID_2: userId}); err != nil {
+		return err
+	}
+	if err := queries.DeletePlayerBlocksByUserId(ctx, db.DeletePlayerBlocksByUserIdParams{UserID: userId, UserID_2: userId}); err != nil {
+		return err
+	}
+
+	if err := queries.DeletePlayerByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteUserRole(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteLoginHistoryByUserId(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteAccountDeletion(ctx, userId); err != nil {
+		return err
+	}
+	if err := queries.DeleteUser(ctx, userId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Takes the user's player out of what's kept of other players' records once their account is purged: reports filed by
+// or against them, and whispers sent to them, which only have the names they went by at the time
+func anonymizePlayer(ctx context.Context, queries *db.Queries, userId int64) error {
+	player, err := queries.GetPlayerByUserId(ctx, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error getting player: %w", err)
+	}
+
+	names := []string{player.Name}
+	history, err := queries.GetPlayerNameHistory(ctx, player.ID)
+	if err != nil {
+		return fmt.Errorf("error getting name history: %w", err)
+	}
+	for _, entry := range history {
+		names = append(names, entry.Name)
+	}
+
+	if err := anonymizePlayerReports(ctx, queries, player, names); err != nil {
+		return err
+	}
+	for _, name := range names {
+		err := queries.AnonymizeChatMessageTargets(ctx, db.AnonymizeChatMessageTargetsParams{Target: DeletedPlayerName, Target_2: name})
+		if err != nil {
+			return fmt.Errorf("error anonymizing whispers: %w", err)
+		}
+	}
+	return nil
+}
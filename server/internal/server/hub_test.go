@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"server/internal/server/db"
+	"strings"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+func TestPurgeAccountLeavesNothingBehind(t *testing.T) {
+	ctx := context.Background()
+	dbPool, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "db.sqlite"))
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	t.Cleanup(func() { dbPool.Close() })
+	if err := InitializeDb(ctx, dbPool); err != nil {
+		t.Fatalf("initializing database: %v", err)
+	}
+	queries := db.New(dbPool)
+	now := time.Now()
+
+	createPlayer := func(name string) (db.User, db.Player) {
+		user, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "user_" + name, PasswordHash: "hash"})
+		if err != nil {
+			t.Fatalf("creating user for %q: %v", name, err)
+		}
+		player, err := queries.CreatePlayer(ctx, db.CreatePlayerParams{UserID: user.ID, Name: name, Color: -1})
+		if err != nil {
+			t.Fatalf("creating player %q: %v", name, err)
+		}
+		return user, player
+	}
+	purgedUser, purged := createPlayer("Mallory")
+	_, other := createPlayer("Bob")
+
+	// Mallory used to go by another name, which reports from back then still have
+	err = queries.CreatePlayerNameHistory(ctx, db.CreatePlayerNameHistoryParams{PlayerID: purged.ID, Name: "OldMallory", ChangedAt: now.Unix()})
+	if err != nil {
+		t.Fatalf("recording name history: %v", err)
+	}
+
+	for _, chat := range []db.CreateChatMessageParams{
+		{SenderPlayerID: purged.ID, SenderName: "Mallory", Msg: "mallory's secret", SentAt: now.Unix()},
+		{SenderPlayerID: other.ID, SenderName: "Bob", Target: "Mallory", Msg: "bob's reply", SentAt: now.Unix()},
+	} {
+		if err := queries.CreateChatMessage(ctx, chat); err != nil {
+			t.Fatalf("recording chat: %v", err)
+		}
+	}
+
+	reportContext, err := json.Marshal(ReportContext{
+		Chat: []ReportedChat{
+			{SenderName: "OldMallory", Msg: "mallory's secret", SentAt: now},
+			{SenderName: "Bob", Target: "OldMallory", Msg: "bob's reply", SentAt: now},
+		},
+		Consumptions: []ReportedConsumption{{ConsumerName: "OldMallory", ConsumedName: "Bob", At: now}},
+	})
+	if err != nil {
+		t.Fatalf("encoding report context: %v", err)
+	}
+	for _, report := range []db.CreatePlayerReportParams{
+		{ReporterPlayerID: other.ID, ReporterName: "Bob", ReportedPlayerID: purged.ID, ReportedName: "OldMallory", Context: string(reportContext)},
+		{ReporterPlayerID: purged.ID, ReporterName: "OldMallory", ReportedPlayerID: other.ID, ReportedName: "Bob", Context: string(reportContext)},
+	} {
+		if _, err := queries.CreatePlayerReport(ctx, report); err != nil {
+			t.Fatalf("filing report: %v", err)
+		}
+	}
+
+	hub := &Hub{dbPool: dbPool}
+	if err := hub.purgeAccount(ctx, purgedUser.ID); err != nil {
+		t.Fatalf("purging account: %v", err)
+	}
+
+	// Look through every row of every table for anything which could identify the purged user
+	tables, err := dbPool.QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		t.Fatalf("listing tables: %v", err)
+	}
+	var tableNames []string
+	for tables.Next() {
+		var name string
+		if err := tables.Scan(&name); err != nil {
+			t.Fatalf("reading table name: %v", err)
+		}
+		tableNames = append(tableNames, name)
+	}
+	tables.Close()
+
+	for _, table := range tableNames {
+		rows, err := dbPool.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s", table))
+		if err != nil {
+			t.Fatalf("reading %s: %v", table, err)
+		}
+		columns, _ := rows.Columns()
+		for rows.Next() {
+			values := make([]any, len(columns))
+			pointers := make([]any, len(columns))
+			for i := range values {
+				pointers[i] = &values[i]
+			}
+			if err := rows.Scan(pointers...); err != nil {
+				t.Fatalf("reading row of %s: %v", table, err)
+			}
+
+			for i, column := range columns {
+				text := fmt.Sprint(values[i])
+				if strings.Contains(text, "Mallory") || strings.Contains(text, "mallory's secret") {
+					t.Errorf("%s.%s still has %q", table, column, text)
+				}
+				if strings.HasSuffix(column, "player_id") && values[i] == purged.ID {
+					t.Errorf("%s.%s still refers to the purged player", table, column)
+				}
+				if column == "user_id" && values[i] == purgedUser.ID {
+					t.Errorf("%s.%s still refers to the purged user", table, column)
+				}
+			}
+		}
+		rows.Close()
+	}
+
+	// The reports are still there for moderators, with the other player's side intact
+	reports, err := queries.GetPlayerReports(ctx, 10)
+	if err != nil {
+		t.Fatalf("getting reports: %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("got %d reports after purging, want 2", len(reports))
+	}
+	for _, report := range reports {
+		if !strings.Contains(report.Context, "bob's reply") {
+			t.Errorf("report %d lost the other player's chat: %s", report.ID, report.Context)
+		}
+	}
+}
+
+func TestDbTxInTransactionRollsBackOnError(t *testing.T) {
+	ctx := context.Background()
+	dbPool, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "db.sqlite"))
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	t.Cleanup(func() { dbPool.Close() })
+	if err := InitializeDb(ctx, dbPool); err != nil {
+		t.Fatalf("initializing database: %v", err)
+	}
+	dbTx := (&Hub{dbPool: dbPool}).NewDbTx()
+
+	failure := errors.New("second query failed")
+	err = dbTx.InTransaction(func(queries *db.Queries) error {
+		if _, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "rolled_back", PasswordHash: "hash"}); err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("got %v, want %v", err, failure)
+	}
+	if _, err := dbTx.Queries.GetUserByUsername(ctx, "rolled_back"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("user from the failed transaction was kept (got %v, want %v)", err, sql.ErrNoRows)
+	}
+
+	err = dbTx.InTransaction(func(queries *db.Queries) error {
+		_, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "committed", PasswordHash: "hash"})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("successful transaction got %v", err)
+	}
+	if _, err := dbTx.Queries.GetUserByUsername(ctx, "committed"); err != nil {
+		t.Errorf("user from the successful transaction wasn't kept: %v", err)
+	}
+}
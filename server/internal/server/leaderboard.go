@@ -0,0 +1,86 @@
+package server
+
+import (
+	"cmp"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"time"
+)
+
+const (
+	// How many of the heaviest players in an arena are shown on the live leaderboard
+	LeaderboardSize = 10
+
+	// How often everyone in the game is sent the live leaderboard
+	LeaderboardInterval = time.Second
+)
+
+// A player in the game, as they stood when the leaderboard was worked out
+type leaderboardPlayer struct {
+	clientId uint64
+	name     string
+	mass     uint64
+	rank     uint64
+}
+
+func (h *Hub) broadcastLeaderboardLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		h.broadcastLeaderboard()
+	}
+}
+
+// Sends everyone in the game the heaviest players in their arena right now, along with their own rank
+func (h *Hub) broadcastLeaderboard() {
+	arenas := make(map[string][]leaderboardPlayer)
+	h.SharedGameObjects.Players.ForEach(func(clientId uint64, player *objects.Player) {
+		arenas[player.Arena] = append(arenas[player.Arena], leaderboardPlayer{
+			clientId: clientId,
+			name:     player.Name,
+			mass:     uint64(math.Round(player.Mass())),
+		})
+	})
+
+	for _, players := range arenas {
+		rankLeaderboard(players)
+
+		entries := make([]*packets.LeaderboardEntryMessage, 0, min(len(players), LeaderboardSize))
+		for _, player := range players[:min(len(players), LeaderboardSize)] {
+			entries = append(entries, &packets.LeaderboardEntryMessage{
+				Rank: player.rank,
+				Id:   player.clientId,
+				Name: player.name,
+				Mass: player.mass,
+			})
+		}
+
+		for _, player := range players {
+			if client, exists := h.Clients.Get(player.clientId); exists {
+				client.SocketSendAs(packets.NewLeaderboard(entries, player.rank, player.mass, len(players)), 0)
+			}
+		}
+	}
+}
+
+// Sorts the players from heaviest to lightest and ranks them. Players with the same mass share a rank, and the
+// next rank down skips as many places as were shared, e.g. 1, 2, 2, 4.
+func rankLeaderboard(players []leaderboardPlayer) {
+	slices.SortFunc(players, func(a, b leaderboardPlayer) int {
+		if c := cmp.Compare(b.mass, a.mass); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.clientId, b.clientId)
+	})
+
+	for i := range players {
+		if i > 0 && players[i].mass == players[i-1].mass {
+			players[i].rank = players[i-1].rank
+		} else {
+			players[i].rank = uint64(i + 1)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Package logging writes structured logs with log/slog, as text or JSON. Each part of the server logs through its own
+// subsystem, whose level can be changed while the server is running, e.g. to see debug logs from the game states
+// without drowning in them from everywhere else.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// The subsystem used by anything which logs with the standard log package
+const DefaultSubsystem = "server"
+
+var (
+	// Where every subsystem's records end up, swapped out by Setup
+	output atomic.Pointer[slog.Handler]
+
+	// The level of every subsystem which hasn't been given one of its own
+	defaultLevel slog.LevelVar
+
+	subsystems   = make(map[string]*subsystem)
+	subsystemMux sync.Mutex
+)
+
+func init() {
+	var handler slog.Handler = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+	output.Store(&handler)
+}
+
+// Sets where logs are written, which level subsystems log at unless they're given their own, and whether to write
+// JSON rather than text. Anything logged with the standard log package goes through the default subsystem from now on.
+func Setup(writer io.Writer, level slog.Level, json bool) {
+	// Subsystems do their own level filtering, so the output has to let everything through
+	options := &slog.HandlerOptions{Level: slog.LevelDebug}
+
+	var handler slog.Handler
+	if json {
+		handler = slog.NewJSONHandler(writer, options)
+	} else {
+		handler = slog.NewTextHandler(writer, options)
+	}
+	output.Store(&handler)
+	defaultLevel.Set(level)
+
+	slog.SetDefault(Subsystem(DefaultSubsystem).slog)
+}
+
+// Parses a level like "debug", "info", "warn" or "error"
+func ParseLevel(level string) (slog.Level, error) {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q", level)
+	}
+	return parsed, nil
+}
+
+// Parses a comma-separated list of subsystem levels, e.g. "states=debug,hub=warn"
+func ParseSubsystemLevels(levels string) (map[string]slog.Level, error) {
+	parsed := make(map[string]slog.Level)
+	for _, pair := range strings.Split(levels, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		name, level, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("expected subsystem=level, got %q", pair)
+		}
+
+		parsedLevel, err := ParseLevel(strings.TrimSpace(level))
+		if err != nil {
+			return nil, err
+		}
+		parsed[strings.TrimSpace(name)] = parsedLevel
+	}
+	return parsed, nil
+}
+
+type subsystem struct {
+	name       string
+	level      slog.LevelVar
+	overridden atomic.Bool
+}
+
+func (s *subsystem) enabled(level slog.Level) bool {
+	if s.overridden.Load() {
+		return level >= s.level.Level()
+	}
+	return level >= defaultLevel.Level()
+}
+
+func getSubsystem(name string) *subsystem {
+	subsystemMux.Lock()
+	defer subsystemMux.Unlock()
+
+	sub, exists := subsystems[name]
+	if !exists {
+		sub = &subsystem{name: name}
+		subsystems[name] = sub
+	}
+	return sub
+}
+
+// A logger for the named part of the server, which is created the first time it's asked for
+func Subsystem(name string) *Logger {
+	handler := &subsystemHandler{subsystem: getSubsystem(name)}
+	return &Logger{slog: slog.New(handler).With("subsystem", name)}
+}
+
+// Sets the level of a subsystem which has already logged something, or of every subsystem without a level of its
+// own if the name is "default"
+func SetLevel(name string, level slog.Level) error {
+	if name == "default" {
+		defaultLevel.Set(level)
+		return nil
+	}
+
+	subsystemMux.Lock()
+	sub, exists := subsystems[name]
+	subsystemMux.Unlock()
+	if !exists {
+		return fmt.Errorf("unknown subsystem %s", name)
+	}
+
+	sub.level.Set(level)
+	sub.overridden.Store(true)
+	return nil
+}
+
+// Puts a subsystem back to logging at the default level
+func ResetLevel(name string) error {
+	subsystemMux.Lock()
+	sub, exists := subsystems[name]
+	subsystemMux.Unlock()
+	if !exists {
+		return fmt.Errorf("unknown subsystem %s", name)
+	}
+
+	sub.overridden.Store(false)
+	return nil
+}
+
+// The level a subsystem is logging at
+type SubsystemLevel struct {
+	Name  string `json:"name"`
+	Level string `json:"level"`
+
+	// Whether the subsystem has a level of its own, rather than using the default
+	Overridden bool `json:"overridden"`
+}
+
+// The default level followed by every subsystem's, in alphabetical order
+func Levels() []SubsystemLevel {
+	subsystemMux.Lock()
+	defer subsystemMux.Unlock()
+
+	levels := make([]SubsystemLevel, 0, len(subsystems)+1)
+	levels = append(levels, SubsystemLevel{Name: "default", Level: defaultLevel.Level().String()})
+	for _, sub := range subsystems {
+		level := SubsystemLevel{Name: sub.name, Level: defaultLevel.Level().String()}
+		if sub.overridden.Load() {
+			level.Level = sub.level.Level().String()
+			level.Overridden = true
+		}
+		levels = append(levels, level)
+	}
+
+	sort.Slice(levels[1:], func(i, j int) bool {
+		return levels[i+1].Name < levels[j+1].Name
+	})
+	return levels
+}
+
+// Filters records by its subsystem's level, then passes them on to whatever output is set up when they're logged
+type subsystemHandler struct {
+	subsystem *subsystem
+	attrs     []slog.Attr
+	groups    []string
+}
+
+func (h *subsystemHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.subsystem.enabled(level)
+}
+
+func (h *subsystemHandler) Handle(ctx context.Context, record slog.Record) error {
+	recordAttrs := make([]any, 0, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		recordAttrs = append(recordAttrs, attr)
+		return true
+	})
+
+	out := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	for _, attr := range h.attrs {
+		out.AddAttrs(attr)
+	}
+	out.Add(nestInGroups(h.groups, recordAttrs)...)
+
+	return (*output.Load()).Handle(ctx, out)
+}
+
+func (h *subsystemHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	nested := make([]any, len(attrs))
+	for i, attr := range attrs {
+		nested[i] = attr
+	}
+
+	combined := make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	combined = append(combined, h.attrs...)
+	for _, attr := range nestInGroups(h.groups, nested) {
+		combined = append(combined, attr.(slog.Attr))
+	}
+	return &subsystemHandler{subsystem: h.subsystem, attrs: combined, groups: h.groups}
+}
+
+func (h *subsystemHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	groups := append(append([]string{}, h.groups...), name)
+	return &subsystemHandler{subsystem: h.subsystem, attrs: h.attrs, groups: groups}
+}
+
+// Wraps the attributes in the groups, innermost last
+func nestInGroups(groups []string, attrs []any) []any {
+	if len(attrs) == 0 {
+		return attrs
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		attrs = []any{slog.Group(groups[i], attrs...)}
+	}
+	return attrs
+}
+
+// Logs formatted messages at a level, with structured attributes attached to every record
+type Logger struct {
+	slog *slog.Logger
+}
+
+// A logger which attaches the attributes, given as alternating keys and values, to everything it logs
+func (l *Logger) With(args ...any) *Logger {
+	return &Logger{slog: l.slog.With(args...)}
+}
+
+func (l *Logger) Slog() *slog.Logger {
+	return l.slog
+}
+
+func (l *Logger) logf(level slog.Level, format string, args ...any) {
+	ctx := context.Background()
+	if !l.slog.Enabled(ctx, level) {
+		return
+	}
+	l.slog.Log(ctx, level, fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Debugf(format string, args ...any) {
+	l.logf(slog.LevelDebug, format, args...)
+}
+
+// Logs at the info level, like the standard library's log.Printf
+func (l *Logger) Printf(format string, args ...any) {
+	l.logf(slog.LevelInfo, format, args...)
+}
+
+// Logs at the info level, like the standard library's log.Println
+func (l *Logger) Println(args ...any) {
+	ctx := context.Background()
+	if !l.slog.Enabled(ctx, slog.LevelInfo) {
+		return
+	}
+	l.slog.Log(ctx, slog.LevelInfo, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+func (l *Logger) Warnf(format string, args ...any) {
+	l.logf(slog.LevelWarn, format, args...)
+}
+
+func (l *Logger) Errorf(format string, args ...any) {
+	l.logf(slog.LevelError, format, args...)
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"reflect"
+	"runtime"
+	"server/pkg/packets"
+	"strings"
+)
+
+var (
+	ConnectedClients = NewGaugeFunc("game_connected_clients", "Number of clients connected to the hub")
+	ClientsByState   = NewGauge("game_clients_by_state", "Number of clients in each state", "state")
+	Spores           = NewGaugeFunc("game_spores", "Number of spores in the world")
+	HubHeartbeatAge  = NewGaugeFunc("game_hub_heartbeat_age_seconds", "Time since the hub's main loop last checked in")
+
+	PacketsReceived = NewCounter("game_packets_received_total", "Packets received from clients, by message type", "type")
+	PacketsSent     = NewCounter("game_packets_sent_total", "Packets written to clients, by message type", "type")
+	PacketsDropped  = NewCounter("game_packets_dropped_total", "Packets dropped because a client's send channel was full, by message type", "type")
+	BytesSent       = NewCounter("game_bytes_sent_total", "Bytes written to clients")
+
+	TickDuration    = NewHistogram("game_tick_duration_seconds", "Time taken to update a player each tick", DurationBuckets)
+	DbQueryDuration = NewHistogram("game_db_query_duration_seconds", "Time taken by database queries, by query name", DurationBuckets, "query")
+
+	Logins = NewCounter("game_logins_total", "Login attempts, by result", "result")
+
+	goroutines = NewGaugeFunc("go_goroutines", "Number of goroutines that currently exist")
+)
+
+func init() {
+	goroutines.Set(func() float64 {
+		return float64(runtime.NumGoroutine())
+	})
+}
+
+// The type of message in a packet, e.g. "Chat" for a chat message, to label metrics with
+func MessageType(message packets.Msg) string {
+	if message == nil {
+		return "None"
+	}
+
+	messageType := reflect.TypeOf(message)
+	if messageType.Kind() == reflect.Pointer {
+		messageType = messageType.Elem()
+	}
+	return strings.TrimPrefix(messageType.Name(), "Packet_")
+}
@@ -0,0 +1,290 @@
+// Package metrics keeps counts of what the server is up to, and serves them in the Prometheus text format so they
+// can be scraped from the /metrics endpoint.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Bucket upper bounds for timings in seconds, from a tenth of a millisecond up to a second
+var DurationBuckets = []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+type metric interface {
+	name() string
+	write(w io.Writer)
+}
+
+var (
+	registry    []metric
+	registryMux sync.Mutex
+)
+
+func register(m metric) {
+	registryMux.Lock()
+	defer registryMux.Unlock()
+	registry = append(registry, m)
+}
+
+// Serves every metric in the Prometheus text format
+func Handler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		registryMux.Lock()
+		metrics := make([]metric, len(registry))
+		copy(metrics, registry)
+		registryMux.Unlock()
+
+		sort.Slice(metrics, func(i, j int) bool {
+			return metrics[i].name() < metrics[j].name()
+		})
+
+		writer.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		buffered := bufio.NewWriter(writer)
+		for _, m := range metrics {
+			m.write(buffered)
+		}
+		if err := buffered.Flush(); err != nil {
+			log.Printf("Error writing metrics: %v", err)
+		}
+	})
+}
+
+// The name, help text and labels shared by every kind of metric
+type desc struct {
+	metricName string
+	help       string
+	labelNames []string
+}
+
+func (d *desc) name() string {
+	return d.metricName
+}
+
+func (d *desc) writeHeader(w io.Writer, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", d.metricName, strings.ReplaceAll(d.help, "\n", " "))
+	fmt.Fprintf(w, "# TYPE %s %s\n", d.metricName, kind)
+}
+
+// Identifies a series by its label values, checking there's one for each label
+func (d *desc) key(labelValues []string) string {
+	if len(labelValues) != len(d.labelNames) {
+		panic(fmt.Sprintf("metric %s takes %d label values, got %d", d.metricName, len(d.labelNames), len(labelValues)))
+	}
+	return strings.Join(labelValues, "\xff")
+}
+
+// Formats the labels for a series, e.g. {type="chat"}, with any extra label added on the end
+func (d *desc) labels(key string, extra ...string) string {
+	pairs := make([]string, 0, len(d.labelNames)+1)
+	if len(d.labelNames) > 0 {
+		for i, value := range strings.Split(key, "\xff") {
+			pairs = append(pairs, fmt.Sprintf(`%s="%s"`, d.labelNames[i], labelEscaper.Replace(value)))
+		}
+	}
+	if len(extra) == 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, extra[0], labelEscaper.Replace(extra[1])))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func sortedKeys[T any](series map[string]T) []string {
+	keys := make([]string, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// A value which only goes up, optionally split up by labels
+type Counter struct {
+	desc
+	values map[string]float64
+	mux    sync.Mutex
+}
+
+func NewCounter(name, help string, labelNames ...string) *Counter {
+	c := &Counter{desc: desc{metricName: name, help: help, labelNames: labelNames}, values: make(map[string]float64)}
+	register(c)
+	return c
+}
+
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+func (c *Counter) Add(delta float64, labelValues ...string) {
+	key := c.key(labelValues)
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.values[key] += delta
+}
+
+func (c *Counter) write(w io.Writer) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.writeHeader(w, "counter")
+	for _, key := range sortedKeys(c.values) {
+		fmt.Fprintf(w, "%s%s %s\n", c.metricName, c.labels(key), formatValue(c.values[key]))
+	}
+}
+
+// A value which can go up and down, optionally split up by labels
+type Gauge struct {
+	desc
+	values map[string]float64
+	mux    sync.Mutex
+}
+
+func NewGauge(name, help string, labelNames ...string) *Gauge {
+	g := &Gauge{desc: desc{metricName: name, help: help, labelNames: labelNames}, values: make(map[string]float64)}
+	register(g)
+	return g
+}
+
+func (g *Gauge) Set(value float64, labelValues ...string) {
+	key := g.key(labelValues)
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.values[key] = value
+}
+
+func (g *Gauge) Add(delta float64, labelValues ...string) {
+	key := g.key(labelValues)
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.values[key] += delta
+}
+
+func (g *Gauge) write(w io.Writer) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	g.writeHeader(w, "gauge")
+	for _, key := range sortedKeys(g.values) {
+		fmt.Fprintf(w, "%s%s %s\n", g.metricName, g.labels(key), formatValue(g.values[key]))
+	}
+}
+
+// A gauge whose value is worked out whenever the metrics are scraped, for things which are already counted elsewhere
+type GaugeFunc struct {
+	desc
+	value func() float64
+	mux   sync.Mutex
+}
+
+func NewGaugeFunc(name, help string) *GaugeFunc {
+	g := &GaugeFunc{desc: desc{metricName: name, help: help}}
+	register(g)
+	return g
+}
+
+// Sets where the gauge gets its value from. Until this is called, the gauge isn't reported.
+func (g *GaugeFunc) Set(value func() float64) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.value = value
+}
+
+func (g *GaugeFunc) write(w io.Writer) {
+	g.mux.Lock()
+	value := g.value
+	g.mux.Unlock()
+
+	if value == nil {
+		return
+	}
+	g.writeHeader(w, "gauge")
+	fmt.Fprintf(w, "%s %s\n", g.metricName, formatValue(value()))
+}
+
+type histogramSeries struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// Counts observations into buckets, e.g. how long something took, optionally split up by labels
+type Histogram struct {
+	desc
+	buckets []float64
+	series  map[string]*histogramSeries
+	mux     sync.Mutex
+}
+
+// The buckets are the upper bounds of each bucket, in ascending order
+func NewHistogram(name, help string, buckets []float64, labelNames ...string) *Histogram {
+	h := &Histogram{
+		desc:    desc{metricName: name, help: help, labelNames: labelNames},
+		buckets: buckets,
+		series:  make(map[string]*histogramSeries),
+	}
+	register(h)
+	return h
+}
+
+func (h *Histogram) Observe(value float64, labelValues ...string) {
+	key := h.key(labelValues)
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	series, exists := h.series[key]
+	if !exists {
+		series = &histogramSeries{counts: make([]uint64, len(h.buckets))}
+		h.series[key] = series
+	}
+
+	for i, upperBound := range h.buckets {
+		if value <= upperBound {
+			series.counts[i]++
+		}
+	}
+	series.sum += value
+	series.count++
+}
+
+// Observes how long it's been since the start, in seconds
+func (h *Histogram) ObserveSince(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+func (h *Histogram) write(w io.Writer) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.writeHeader(w, "histogram")
+	for _, key := range sortedKeys(h.series) {
+		series := h.series[key]
+		for i, upperBound := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.metricName, h.labels(key, "le", formatValue(upperBound)), series.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.metricName, h.labels(key, "le", "+Inf"), series.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.metricName, h.labels(key), formatValue(series.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.metricName, h.labels(key), series.count)
+	}
+}
@@ -0,0 +1,67 @@
+package objects
+
+import (
+	"sync"
+	"time"
+)
+
+// A record of one player consuming another
+type Consumption struct {
+	ConsumerDbId   int64
+	ConsumerName   string
+	ConsumerRadius float64
+	ConsumedDbId   int64
+	ConsumedName   string
+	ConsumedRadius float64
+	X              float64
+	Y              float64
+	At             time.Time
+}
+
+// A thread-safe, fixed-size history of the most recent consumptions. Once it's full, the oldest are forgotten.
+type ConsumptionLog struct {
+	events []Consumption
+	next   int
+	full   bool
+	mux    sync.Mutex
+}
+
+func NewConsumptionLog(capacity int) *ConsumptionLog {
+	return &ConsumptionLog{
+		events: make([]Consumption, capacity),
+	}
+}
+
+func (l *ConsumptionLog) Add(consumption Consumption) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.events[l.next] = consumption
+	l.next = (l.next + 1) % len(l.events)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Get the consumptions since the given time where one of the players (by database ID) consumed the other, oldest first
+func (l *ConsumptionLog) Between(dbIdA, dbIdB int64, since time.Time) []Consumption {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	start, count := 0, l.next
+	if l.full {
+		start, count = l.next, len(l.events)
+	}
+
+	var found []Consumption
+	for i := 0; i < count; i++ {
+		event := l.events[(start+i)%len(l.events)]
+		if event.At.Before(since) {
+			continue
+		}
+		if (event.ConsumerDbId == dbIdA && event.ConsumedDbId == dbIdB) || (event.ConsumerDbId == dbIdB && event.ConsumedDbId == dbIdA) {
+			found = append(found, event)
+		}
+	}
+	return found
+}
@@ -1,6 +1,9 @@
 package objects
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 type Player struct {
 	Name      string
@@ -12,6 +15,16 @@ type Player struct {
 	BestScore int64
 	DbId      int64
 	Color     int32
+	Arena     string
+
+	// The player's level and the name of the badge they're wearing, if any, which are shown alongside their name
+	Level int64
+	Badge string
+}
+
+// How much the player weighs, which is what their score is based on
+func (p *Player) Mass() float64 {
+	return math.Pi * p.Radius * p.Radius
 }
 
 type Spore struct {
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type Role string
+
+const (
+	RolePlayer    Role = "player"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// Something only certain roles are allowed to do
+type Permission int
+
+const (
+	PermissionKick Permission = iota
+	PermissionMute
+	PermissionAnnounce
+	PermissionBan
+	PermissionViewChatLog
+)
+
+var rolePermissions = map[Role][]Permission{
+	RolePlayer:    {},
+	RoleModerator: {PermissionKick, PermissionMute, PermissionAnnounce, PermissionBan, PermissionViewChatLog},
+	RoleAdmin:     {PermissionKick, PermissionMute, PermissionAnnounce, PermissionBan, PermissionViewChatLog},
+}
+
+// How senior each role is. Nobody can take action against someone as senior as them or more.
+var roleRanks = map[Role]int{
+	RolePlayer:    0,
+	RoleModerator: 1,
+	RoleAdmin:     2,
+}
+
+var (
+	ErrNotLoggedIn      = errors.New("not logged in")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrNotOutranked     = errors.New("target's role is as senior or more")
+)
+
+func ParseRole(role string) (Role, error) {
+	if _, exists := rolePermissions[Role(role)]; !exists {
+		return "", fmt.Errorf("unknown role %q", role)
+	}
+	return Role(role), nil
+}
+
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the role is more senior than the other
+func (r Role) Outranks(other Role) bool {
+	return roleRanks[r] > roleRanks[other]
+}
+
+// Get the role of the user with the given ID. Users without a role stored are players.
+func GetUserRole(dbTx *DbTx, userId int64) (Role, error) {
+	role, err := dbTx.Queries.GetUserRole(dbTx.Ctx, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return RolePlayer, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return ParseRole(role)
+}
+
+// Check whether the account the client is logged into has the given permission.
+// The role is looked up fresh each time, so promotions and demotions take effect immediately.
+func CheckPermission(client ClientInterfacer, permission Permission) error {
+	userId := client.AccountId()
+	if userId == 0 {
+		return ErrNotLoggedIn
+	}
+
+	role, err := GetUserRole(client.DbTx(), userId)
+	if err != nil {
+		return fmt.Errorf("error getting role of user %d: %w", userId, err)
+	}
+
+	if !role.Can(permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// Check whether the account the client is logged into is more senior than the target user, e.g. so moderators can't
+// kick, mute or ban each other or admins.
+func CheckOutranks(client ClientInterfacer, targetUserId int64) error {
+	userId := client.AccountId()
+	if userId == 0 {
+		return ErrNotLoggedIn
+	}
+
+	role, err := GetUserRole(client.DbTx(), userId)
+	if err != nil {
+		return fmt.Errorf("error getting role of user %d: %w", userId, err)
+	}
+	targetRole, err := GetUserRole(client.DbTx(), targetUserId)
+	if err != nil {
+		return fmt.Errorf("error getting role of user %d: %w", targetUserId, err)
+	}
+
+	if !role.Outranks(targetRole) {
+		return ErrNotOutranked
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"server/internal/server/db"
+	"server/pkg/packets"
+)
+
+const (
+	// Nobody can level up past this
+	MaxLevel = 100
+
+	// How much XP each thing a player gets up to in a session is worth
+	xpPerSporeEaten  = 1
+	xpPerPlayerEaten = 20
+	xpPerMinuteAlive = 10
+	massPerXp        = 1000
+)
+
+// Something players can show off once they reach a high enough level
+type Cosmetic struct {
+	Id   string
+	Kind packets.CosmeticKind
+	Name string
+
+	// Only set for colors, in RGBA32
+	Color uint32
+
+	// The level the player has to reach to unlock it
+	Level int64
+}
+
+// Every cosmetic in the game, in the order they unlock. Players start off with the level 1 colors to choose from.
+var Cosmetics = []Cosmetic{
+	{Id: "color_red", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Red", Color: 0xE53935FF, Level: 1},
+	{Id: "color_orange", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Orange", Color: 0xFB8C00FF, Level: 1},
+	{Id: "color_yellow", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Yellow", Color: 0xFDD835FF, Level: 1},
+	{Id: "color_green", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Green", Color: 0x43A047FF, Level: 1},
+	{Id: "color_teal", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Teal", Color: 0x00897BFF, Level: 1},
+	{Id: "color_blue", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Blue", Color: 0x1E88E5FF, Level: 1},
+	{Id: "color_purple", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Purple", Color: 0x8E24AAFF, Level: 1},
+	{Id: "color_pink", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Pink", Color: 0xD81B60FF, Level: 1},
+	{Id: "color_white", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "White", Color: 0xFFFFFFFF, Level: 1},
+	{Id: "color_grey", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Grey", Color: 0x757575FF, Level: 1},
+	{Id: "badge_sprout", Kind: packets.CosmeticKind_COSMETIC_KIND_BADGE, Name: "Sprout", Level: 2},
+	{Id: "color_mint", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Mint", Color: 0x98FF98FF, Level: 3},
+	{Id: "badge_hungry", Kind: packets.CosmeticKind_COSMETIC_KIND_BADGE, Name: "Hungry", Level: 5},
+	{Id: "color_gold", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Gold", Color: 0xFFD700FF, Level: 10},
+	{Id: "badge_veteran", Kind: packets.CosmeticKind_COSMETIC_KIND_BADGE, Name: "Veteran", Level: 15},
+	{Id: "color_obsidian", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Obsidian", Color: 0x1B1B1BFF, Level: 20},
+	{Id: "badge_devourer", Kind: packets.CosmeticKind_COSMETIC_KIND_BADGE, Name: "Devourer", Level: 30},
+	{Id: "color_prismatic", Kind: packets.CosmeticKind_COSMETIC_KIND_COLOR, Name: "Prismatic", Color: 0x7DF9FFFF, Level: 50},
+	{Id: "badge_legend", Kind: packets.CosmeticKind_COSMETIC_KIND_BADGE, Name: "Legend", Level: 75},
+}
+
+// How much XP a session's stats are worth
+func SessionXp(totals StatsTotals) int64 {
+	return totals.SporesEaten*xpPerSporeEaten +
+		totals.PlayersEaten*xpPerPlayerEaten +
+		totals.SecondsAlive*xpPerMinuteAlive/60 +
+		totals.MaxMass/massPerXp
+}
+
+// How much XP it takes in total to reach the level. Each level takes 100 XP more than the one before it.
+func XpForLevel(level int64) int64 {
+	return 100 * (level - 1) * level / 2
+}
+
+// The level a player with this much XP is at
+func LevelForXp(xp int64) int64 {
+	level := int64(1)
+	for level < MaxLevel && xp >= XpForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// The player's progress, or a fresh start if they haven't earned any XP yet
+func GetPlayerProgress(dbTx *DbTx, playerDbId int64) (db.PlayerProgress, error) {
+	progress, err := dbTx.Queries.GetPlayerProgress(dbTx.Ctx, playerDbId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.PlayerProgress{PlayerID: playerDbId}, nil
+	}
+	return progress, err
+}
+
+func FindCosmetic(id string) (Cosmetic, bool) {
+	for _, cosmetic := range Cosmetics {
+		if cosmetic.Id == id {
+			return cosmetic, true
+		}
+	}
+	return Cosmetic{}, false
+}
+
+// The color cosmetic with the given RGBA32 value, if there is one
+func FindColorCosmetic(color int32) (Cosmetic, bool) {
+	for _, cosmetic := range Cosmetics {
+		if cosmetic.Kind == packets.CosmeticKind_COSMETIC_KIND_COLOR && cosmetic.Color == uint32(color) {
+			return cosmetic, true
+		}
+	}
+	return Cosmetic{}, false
+}
+
+// The color cosmetic a player at the given level owns which is closest to the RGBA32 color given, e.g. to turn whatever
+// a color picker gave us into a color the player is allowed to use
+func NearestOwnedColor(level int64, color int32) Cosmetic {
+	var nearest Cosmetic
+	nearestDistance := -1
+	for _, cosmetic := range Cosmetics {
+		if cosmetic.Kind != packets.CosmeticKind_COSMETIC_KIND_COLOR || cosmetic.Level > level {
+			continue
+		}
+		if distance := colorDistance(uint32(color), cosmetic.Color); nearestDistance < 0 || distance < nearestDistance {
+			nearest, nearestDistance = cosmetic, distance
+		}
+	}
+	return nearest
+}
+
+// The squared distance between two RGBA32 colors' red, green and blue, ignoring their alpha
+func colorDistance(a, b uint32) int {
+	distance := 0
+	for shift := 8; shift <= 24; shift += 8 {
+		diff := int(a>>shift&0xFF) - int(b>>shift&0xFF)
+		distance += diff * diff
+	}
+	return distance
+}
+
+// Whether a player at the given level can use the color
+func ColorUnlocked(level int64, color int32) bool {
+	cosmetic, exists := FindColorCosmetic(color)
+	return exists && cosmetic.Level <= level
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// How many reports a player can file within the rate limit window
+	ReportRateLimit  = 5
+	ReportRateWindow = time.Hour
+
+	// How far back to look for chat and consumptions to attach to a report
+	reportContextWindow = 10 * time.Minute
+
+	// The most chat messages from each player to attach to a report
+	reportContextChatLimit = 20
+)
+
+var ErrTooManyReports = errors.New("too many reports filed recently")
+
+type ReportedPosition struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Radius float64 `json:"radius"`
+}
+
+type ReportedChat struct {
+	SenderName string    `json:"sender_name"`
+	Channel    string    `json:"channel"`
+	Target     string    `json:"target,omitempty"`
+	Msg        string    `json:"msg"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+type ReportedConsumption struct {
+	ConsumerName   string    `json:"consumer_name"`
+	ConsumerRadius float64   `json:"consumer_radius"`
+	ConsumedName   string    `json:"consumed_name"`
+	ConsumedRadius float64   `json:"consumed_radius"`
+	X              float64   `json:"x"`
+	Y              float64   `json:"y"`
+	At             time.Time `json:"at"`
+}
+
+// What was going on between the two players around the time of a report, stored with it as JSON
+type ReportContext struct {
+	// Nil if the player wasn't in the game when the report was filed
+	ReporterPosition *ReportedPosition `json:"reporter_position"`
+	ReportedPosition *ReportedPosition `json:"reported_position"`
+
+	Chat         []ReportedChat        `json:"chat"`
+	Consumptions []ReportedConsumption `json:"consumptions"`
+}
+
+// Record a report against a player, along with the context needed to look into it.
+// Returns ErrTooManyReports if the reporter has filed too many reports recently.
+func FilePlayerReport(dbTx *DbTx, sharedGameObjects *SharedGameObjects, reporterDbId int64, reporterName string, reported db.Player, category int64, details string) (db.PlayerReport, error) {
+	now := time.Now()
+	recentReports, err := dbTx.Queries.CountPlayerReportsByReporterSince(dbTx.Ctx, db.CountPlayerReportsByReporterSinceParams{
+		ReporterPlayerID: reporterDbId,
+		CreatedAt:        now.Add(-ReportRateWindow).Unix(),
+	})
+	if err != nil {
+		return db.PlayerReport{}, fmt.Errorf("error counting recent reports: %w", err)
+	}
+	if recentReports >= ReportRateLimit {
+		return db.PlayerReport{}, ErrTooManyReports
+	}
+
+	context, err := buildReportContext(dbTx, sharedGameObjects, reporterDbId, reported.ID, now)
+	if err != nil {
+		return db.PlayerReport{}, fmt.Errorf("error building report context: %w", err)
+	}
+
+	contextJson, err := json.Marshal(context)
+	if err != nil {
+		return db.PlayerReport{}, fmt.Errorf("error encoding report context: %w", err)
+	}
+
+	return dbTx.Queries.CreatePlayerReport(dbTx.Ctx, db.CreatePlayerReportParams{
+		ReporterPlayerID: reporterDbId,
+		ReporterName:     reporterName,
+		ReportedPlayerID: reported.ID,
+		ReportedName:     reported.Name,
+		Category:         category,
+		Details:          details,
+		Context:          string(contextJson),
+		CreatedAt:        now.Unix(),
+	})
+}
+
+// Mark a report as dealt with, and record who did it in the moderation log
+func ResolvePlayerReport(dbTx *DbTx, actor string, report db.PlayerReport, resolution string) error {
+	resolved, err := dbTx.Queries.ResolvePlayerReport(dbTx.Ctx, db.ResolvePlayerReportParams{
+		ResolvedAt: sql.NullInt64{Int64: time.Now().Unix(), Valid: true},
+		ResolvedBy: actor,
+		Resolution: resolution,
+		ID:         report.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if resolved == 0 {
+		return fmt.Errorf("report %d has already been resolved", report.ID)
+	}
+
+	return LogModerationAction(dbTx, actor, "resolve_report", report.ReportedName, fmt.Sprintf("report %d: %s", report.ID, resolution))
+}
+
+// Takes a player out of the reports they filed or were the subject of, ready for their account to be purged, given
+// every name they've gone by. The reports are kept for moderators, but the player's chat is dropped from them, and their
+// name and player ID are replaced.
+func anonymizePlayerReports(ctx context.Context, queries *db.Queries, player db.Player, names []string) error {
+	reports, err := queries.GetPlayerReportsInvolvingUserId(ctx, db.GetPlayerReportsInvolvingUserIdParams{
+		UserID:   player.UserID,
+		UserID_2: player.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting reports: %w", err)
+	}
+	for _, report := range reports {
+		reportNames := slices.Clone(names)
+		if report.ReporterPlayerID == player.ID {
+			reportNames = append(reportNames, report.ReporterName)
+		}
+		if report.ReportedPlayerID == player.ID {
+			reportNames = append(reportNames, report.ReportedName)
+		}
+
+		scrubbed, err := scrubReportContext(report.Context, reportNames)
+		if err != nil {
+			return fmt.Errorf("error scrubbing context of report %d: %w", report.ID, err)
+		}
+		err = queries.UpdatePlayerReportContext(ctx, db.UpdatePlayerReportContextParams{Context: scrubbed, ID: report.ID})
+		if err != nil {
+			return fmt.Errorf("error updating context of report %d: %w", report.ID, err)
+		}
+	}
+
+	err = queries.AnonymizePlayerReportsAgainstUserId(ctx, db.AnonymizePlayerReportsAgainstUserIdParams{ReportedName: DeletedPlayerName, UserID: player.UserID})
+	if err != nil {
+		return fmt.Errorf("error anonymizing reports against the user: %w", err)
+	}
+	err = queries.AnonymizePlayerReportsByUserId(ctx, db.AnonymizePlayerReportsByUserIdParams{ReporterName: DeletedPlayerName, UserID: player.UserID})
+	if err != nil {
+		return fmt.Errorf("error anonymizing reports by the user: %w", err)
+	}
+	return nil
+}
+
+// Drops chat sent under any of the given names from a report's context, and replaces the names wherever else they
+// appear in it
+func scrubReportContext(contextJson string, names []string) (string, error) {
+	var reportContext ReportContext
+	if err := json.Unmarshal([]byte(contextJson), &reportContext); err != nil {
+		return "", err
+	}
+
+	isScrubbed := func(name string) bool {
+		return slices.ContainsFunc(names, func(scrubbedName string) bool {
+			return strings.EqualFold(name, scrubbedName)
+		})
+	}
+
+	reportContext.Chat = slices.DeleteFunc(reportContext.Chat, func(chat ReportedChat) bool {
+		return isScrubbed(chat.SenderName)
+	})
+	for i, chat := range reportContext.Chat {
+		if isScrubbed(chat.Target) {
+			reportContext.Chat[i].Target = DeletedPlayerName
+		}
+	}
+	for i, consumption := range reportContext.Consumptions {
+		if isScrubbed(consumption.ConsumerName) {
+			reportContext.Consumptions[i].ConsumerName = DeletedPlayerName
+		}
+		if isScrubbed(consumption.ConsumedName) {
+			reportContext.Consumptions[i].ConsumedName = DeletedPlayerName
+		}
+	}
+
+	scrubbed, err := json.Marshal(reportContext)
+	if err != nil {
+		return "", err
+	}
+	return string(scrubbed), nil
+}
+
+func buildReportContext(dbTx *DbTx, sharedGameObjects *SharedGameObjects, reporterDbId, reportedDbId int64, now time.Time) (*ReportContext, error) {
+	since := now.Add(-reportContextWindow)
+	context := &ReportContext{
+		ReporterPosition: findPlayerPosition(sharedGameObjects, reporterDbId),
+		ReportedPosition: findPlayerPosition(sharedGameObjects, reportedDbId),
+		Chat:             []ReportedChat{},
+		Consumptions:     []ReportedConsumption{},
+	}
+
+	for _, playerId := range []int64{reporterDbId, reportedDbId} {
+		messages, err := dbTx.Queries.SearchChatMessagesByPlayer(dbTx.Ctx, db.SearchChatMessagesByPlayerParams{
+			SenderPlayerID: playerId,
+			SentAt:         since.Unix(),
+			SentAt_2:       now.Unix(),
+			Limit:          reportContextChatLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			context.Chat = append(context.Chat, ReportedChat{
+				SenderName: message.SenderName,
+				Channel:    packets.ChatChannel(message.Channel).String(),
+				Target:     message.Target,
+				Msg:        message.Msg,
+				SentAt:     time.Unix(message.SentAt, 0).UTC(),
+			})
+		}
+	}
+	slices.SortStableFunc(context.Chat, func(a, b ReportedChat) int {
+		return cmp.Compare(a.SentAt.Unix(), b.SentAt.Unix())
+	})
+
+	for _, consumption := range sharedGameObjects.Consumptions.Between(reporterDbId, reportedDbId, since) {
+		context.Consumptions = append(context.Consumptions, ReportedConsumption{
+			ConsumerName:   consumption.ConsumerName,
+			ConsumerRadius: consumption.ConsumerRadius,
+			ConsumedName:   consumption.ConsumedName,
+			ConsumedRadius: consumption.ConsumedRadius,
+			X:              consumption.X,
+			Y:              consumption.Y,
+			At:             consumption.At.UTC(),
+		})
+	}
+
+	return context, nil
+}
+
+// Where the player with the given database ID is in the game right now, or nil if they're not in it
+func findPlayerPosition(sharedGameObjects *SharedGameObjects, dbId int64) *ReportedPosition {
+	var position *ReportedPosition
+	sharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		if position == nil && player.DbId == dbId {
+			position = &ReportedPosition{X: player.X, Y: player.Y, Radius: player.Radius}
+		}
+	})
+	return position
+}
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SanctionKind string
+
+const (
+	// Stops the user from logging in
+	SanctionBan SanctionKind = "ban"
+
+	// Stops the user from chatting
+	SanctionMute SanctionKind = "mute"
+)
+
+// How the sanction reads when describing someone under it, e.g. "banned"
+func (k SanctionKind) PastTense() string {
+	switch k {
+	case SanctionBan:
+		return "banned"
+	case SanctionMute:
+		return "muted"
+	}
+	return string(k)
+}
+
+// The actor recorded in the moderation log for actions taken with the admin CLI
+const AdminCliActor = "admin CLI"
+
+// A ban or mute which is currently in force
+type Sanction struct {
+	Reason   string
+	IssuedBy string
+
+	// Zero if the sanction is permanent
+	ExpiresAt time.Time
+}
+
+// Describes the sanction to whoever is on the receiving end of it, e.g. Describe("banned")
+func (s *Sanction) Describe(verb string) string {
+	if s.ExpiresAt.IsZero() {
+		return fmt.Sprintf("You are permanently %s: %s", verb, s.Reason)
+	}
+	return fmt.Sprintf("You are %s until %s: %s", verb, s.ExpiresAt.UTC().Format(time.RFC1123), s.Reason)
+}
+
+func newSanction(reason, issuedBy string, expiresAt sql.NullInt64) *Sanction {
+	sanction := &Sanction{Reason: reason, IssuedBy: issuedBy}
+	if expiresAt.Valid {
+		sanction.ExpiresAt = time.Unix(expiresAt.Int64, 0)
+	}
+	return sanction
+}
+
+func isActive(expiresAt sql.NullInt64, now time.Time) bool {
+	return !expiresAt.Valid || expiresAt.Int64 > now.Unix()
+}
+
+// A duration of zero or less means the sanction never expires
+func expiryFromDuration(now time.Time, duration time.Duration) sql.NullInt64 {
+	if duration <= 0 {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: now.Add(duration).Unix(), Valid: true}
+}
+
+// Parse how long a sanction should last: "permanent", a number of days such as "7d", or a Go duration such as "90m".
+// Permanent sanctions have a duration of zero.
+func ParseSanctionDuration(s string) (time.Duration, error) {
+	if s == "permanent" {
+		return 0, nil
+	}
+
+	if days, found := strings.CutSuffix(s, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return duration, nil
+}
+
+func describeDuration(duration time.Duration) string {
+	if duration <= 0 {
+		return "permanent"
+	}
+	return duration.String()
+}
+
+// Get the sanction of the given kind in force on the user, or nil if there isn't one: sanctions which have expired or
+// been revoked don't count. If there are several, the one which lasts the longest is returned.
+func GetActiveUserSanction(dbTx *DbTx, userId int64, kind SanctionKind) (*Sanction, error) {
+	sanctions, err := dbTx.Queries.GetUserSanctions(dbTx.Ctx, db.GetUserSanctionsParams{
+		UserID: userId,
+		Kind:   string(kind),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var longest *db.UserSanction
+	for i, sanction := range sanctions {
+		if !isActive(sanction.ExpiresAt, now) {
+			continue
+		}
+		if longest == nil || !sanction.ExpiresAt.Valid || (longest.ExpiresAt.Valid && sanction.ExpiresAt.Int64 > longest.ExpiresAt.Int64) {
+			longest = &sanctions[i]
+		}
+	}
+
+	if longest == nil {
+		return nil, nil
+	}
+	return newSanction(longest.Reason, longest.IssuedBy, longest.ExpiresAt), nil
+}
+
+// Get the ban in force on the IP address, or nil if there isn't one
+func GetActiveIpBan(dbTx *DbTx, ipAddress string) (*Sanction, error) {
+	bans, err := dbTx.Queries.GetIpBans(dbTx.Ctx, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, ban := range bans {
+		if isActive(ban.ExpiresAt, now) {
+			return newSanction(ban.Reason, ban.IssuedBy, ban.ExpiresAt), nil
+		}
+	}
+	return nil, nil
+}
+
+// Ban or mute a user, and record who did it in the moderation log. The target is the name to record in the log.
+func IssueUserSanction(dbTx *DbTx, actor string, userId int64, target string, kind SanctionKind, reason string, duration time.Duration) (*Sanction, error) {
+	now := time.Now()
+	sanction, err := dbTx.Queries.CreateUserSanction(dbTx.Ctx, db.CreateUserSanctionParams{
+		UserID:    userId,
+		Kind:      string(kind),
+		Reason:    reason,
+		IssuedBy:  actor,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiryFromDuration(now, duration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("duration: %s, reason: %s", describeDuration(duration), reason)
+	if err := LogModerationAction(dbTx, actor, string(kind), target, details); err != nil {
+		return nil, err
+	}
+
+	return newSanction(sanction.Reason, sanction.IssuedBy, sanction.ExpiresAt), nil
+}
+
+// Lift all sanctions of the given kind from the user. Returns how many were lifted. The sanctions are kept, marked as
+// revoked, so there's a record of what the user was punished for.
+func RevokeUserSanctions(dbTx *DbTx, actor string, userId int64, target string, kind SanctionKind) (int64, error) {
+	revoked, err := dbTx.Queries.RevokeUserSanctions(dbTx.Ctx, db.RevokeUserSanctionsParams{
+		RevokedAt: sql.NullInt64{Int64: time.Now().Unix(), Valid: true},
+		RevokedBy: actor,
+		UserID:    userId,
+		Kind:      string(kind),
+	})
+	if err != nil || revoked == 0 {
+		return revoked, err
+	}
+
+	return revoked, LogModerationAction(dbTx, actor, "un"+string(kind), target, fmt.Sprintf("revoked %d", revoked))
+}
+
+// Lift all bans from the user, along with any bans on the IP address they last logged in from, which is the address
+// banned alongside them when that's asked for. Otherwise unbanning them still wouldn't let them back in.
+// Returns how many bans were lifted from the user and from the address.
+func RevokeUserBans(dbTx *DbTx, actor string, userId int64, target string) (int64, int64, error) {
+	revoked, err := RevokeUserSanctions(dbTx, actor, userId, target, SanctionBan)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ipAddress, err := dbTx.Queries.GetLastLoginIp(dbTx.Ctx, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return revoked, 0, nil
+	} else if err != nil {
+		return revoked, 0, fmt.Errorf("error getting last login IP: %w", err)
+	}
+
+	ipRevoked, err := RevokeIpBans(dbTx, actor, ipAddress)
+	return revoked, ipRevoked, err
+}
+
+// Ban an IP address, and record who did it in the moderation log
+func IssueIpBan(dbTx *DbTx, actor string, ipAddress string, reason string, duration time.Duration) (*Sanction, error) {
+	now := time.Now()
+	ban, err := dbTx.Queries.CreateIpBan(dbTx.Ctx, db.CreateIpBanParams{
+		IpAddress: ipAddress,
+		Reason:    reason,
+		IssuedBy:  actor,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiryFromDuration(now, duration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("duration: %s, reason: %s", describeDuration(duration), reason)
+	if err := LogModerationAction(dbTx, actor, "ip_ban", ipAddress, details); err != nil {
+		return nil, err
+	}
+
+	return newSanction(ban.Reason, ban.IssuedBy, ban.ExpiresAt), nil
+}
+
+// Lift all bans on the IP address. Returns how many were lifted. Like user sanctions, the bans are kept as a record.
+func RevokeIpBans(dbTx *DbTx, actor string, ipAddress string) (int64, error) {
+	revoked, err := dbTx.Queries.RevokeIpBans(dbTx.Ctx, db.RevokeIpBansParams{
+		RevokedAt: sql.NullInt64{Int64: time.Now().Unix(), Valid: true},
+		RevokedBy: actor,
+		IpAddress: ipAddress,
+	})
+	if err != nil || revoked == 0 {
+		return revoked, err
+	}
+
+	return revoked, LogModerationAction(dbTx, actor, "ip_unban", ipAddress, fmt.Sprintf("revoked %d", revoked))
+}
+
+// Record an action taken by a moderator or admin
+func LogModerationAction(dbTx *DbTx, actor, action, target, details string) error {
+	return dbTx.Queries.CreateModerationLogEntry(dbTx.Ctx, db.CreateModerationLogEntryParams{
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		Details:   details,
+		CreatedAt: time.Now().Unix(),
+	})
+}
@@ -0,0 +1,123 @@
+package states
+
+import (
+	"errors"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"time"
+)
+
+func newAchievementMessage(achievement server.Achievement, unlocked bool, unlockedAt int64) *packets.AchievementMessage {
+	return &packets.AchievementMessage{
+		Id:          achievement.Id,
+		Name:        achievement.Name,
+		Description: achievement.Description,
+		Unlocked:    unlocked,
+		UnlockedAt:  unlockedAt,
+	}
+}
+
+// Lists every achievement, showing which ones the named player has unlocked and when. Also returns the player's name
+// as it's stored. Errors returned are meant to be shown to the player as they are.
+func playerAchievements(client server.ClientInterfacer, name string) (string, []*packets.AchievementMessage, error) {
+	dbTx := client.DbTx()
+	player, err := dbTx.Queries.GetPlayerByExactName(dbTx.Ctx, name)
+	if err != nil {
+		logger.Errorf("Error getting player %s to list achievements: %v", name, err)
+		return "", nil, errors.New("No player found with that name")
+	}
+
+	unlocked, err := dbTx.Queries.GetPlayerAchievements(dbTx.Ctx, player.ID)
+	if err != nil {
+		logger.Errorf("Error getting achievements of player %s: %v", player.Name, err)
+		return "", nil, errors.New("Failed to get achievements (internal server error) - please try again later")
+	}
+
+	unlockedAt := make(map[string]int64, len(unlocked))
+	for _, achievement := range unlocked {
+		unlockedAt[achievement.AchievementID] = achievement.UnlockedAt
+	}
+
+	all := client.Achievements().All()
+	achievements := make([]*packets.AchievementMessage, 0, len(all))
+	for _, achievement := range all {
+		at, isUnlocked := unlockedAt[achievement.Id]
+		achievements = append(achievements, newAchievementMessage(achievement, isUnlocked, at))
+	}
+
+	return player.Name, achievements, nil
+}
+
+func (g *InGame) loadAchievements() {
+	unlocked, err := g.client.DbTx().Queries.GetPlayerAchievements(g.client.DbTx().Ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error loading unlocked achievements, tracking all of them: %v", err)
+	}
+
+	unlockedIds := make([]string, 0, len(unlocked))
+	for _, achievement := range unlocked {
+		unlockedIds = append(unlockedIds, achievement.AchievementID)
+	}
+	g.achievements = g.client.Achievements().NewTracker(unlockedIds)
+}
+
+// Counts the event towards our player's stats, and unlocks any achievements it earns them
+func (g *InGame) recordEvent(event server.StatsEvent) {
+	g.client.Stats().Emit(event)
+	for _, achievement := range g.achievements.Observe(event) {
+		g.unlockAchievement(achievement)
+	}
+}
+
+// Unlocks any achievements our player has earned just by staying alive this long
+func (g *InGame) checkSurvivalAchievements() {
+	for _, achievement := range g.achievements.Tick(time.Now()) {
+		g.unlockAchievement(achievement)
+	}
+}
+
+// Saves the achievement our player has just earned, then lets them and everyone in their arena know
+func (g *InGame) unlockAchievement(achievement server.Achievement) {
+	unlockedAt := time.Now().Unix()
+	created, err := g.client.DbTx().Queries.CreatePlayerAchievement(g.client.DbTx().Ctx, db.CreatePlayerAchievementParams{
+		PlayerID:      g.player.DbId,
+		AchievementID: achievement.Id,
+		UnlockedAt:    unlockedAt,
+	})
+	if err != nil {
+		g.logger.Errorf("Error saving achievement %s: %v", achievement.Id, err)
+		return
+	}
+	if created == 0 {
+		// They'd already unlocked it, we just couldn't tell when they joined the game
+		return
+	}
+
+	g.logger.Printf("Player %s unlocked achievement %s", g.player.Name, achievement.Id)
+	message := packets.NewAchievementUnlocked(g.player.Name, newAchievementMessage(achievement, true, unlockedAt))
+	g.client.SocketSend(message)
+
+	// Achievements can be unlocked while handling a broadcast on the hub's goroutine, e.g. reaching a mass as we're
+	// consumed, and the hub can't take a broadcast from its own goroutine
+	go g.client.Broadcast(message)
+}
+
+func (g *InGame) handleAchievementUnlocked(senderId uint64, message *packets.Packet_AchievementUnlocked) {
+	if senderId != g.client.Id() && g.inSameArena(senderId) {
+		g.client.SocketSendAs(message, senderId)
+	}
+}
+
+func (c *Connected) handleAchievementsRequest(senderId uint64, message *packets.Packet_AchievementsRequest) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	name, achievements, err := playerAchievements(c.client, message.AchievementsRequest.Name)
+	if err != nil {
+		c.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+	c.client.SocketSend(packets.NewAchievementList(name, achievements))
+}
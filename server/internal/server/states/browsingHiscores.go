@@ -2,18 +2,94 @@ package states
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"database/sql"
+	"errors"
+	"math"
 	"server/internal/server"
 	"server/internal/server/db"
+	"server/internal/server/logging"
 	"server/pkg/packets"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
+const (
+	// How many hiscores are sent at a time if the client doesn't ask for a particular number
+	hiscorePageSize int64 = 10
+
+	// The most hiscores a client can ask for at a time
+	maxHiscorePageSize int64 = 50
+
+	// The most players a hiscore search returns
+	maxHiscoreSearchResults = 10
+
+	// Names can't be any longer than this, so neither can searches for them
+	maxHiscoreSearchLength = 20
+)
+
+// Which hiscores are being browsed: all-time, a calendar period, or a season
+type hiscoreBoard struct {
+	period packets.HiscorePeriod
+
+	// Only set for the season period
+	season db.Season
+}
+
+// Works out which board a request is for. Errors returned are meant to be shown to the player as they are.
+func selectHiscoreBoard(dbTx *server.DbTx, request *packets.HiscoreBoardRequestMessage) (hiscoreBoard, error) {
+	board := hiscoreBoard{period: request.Period}
+
+	switch request.Period {
+	case packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME,
+		packets.HiscorePeriod_HISCORE_PERIOD_DAILY,
+		packets.HiscorePeriod_HISCORE_PERIOD_WEEKLY,
+		packets.HiscorePeriod_HISCORE_PERIOD_MONTHLY:
+		return board, nil
+	case packets.HiscorePeriod_HISCORE_PERIOD_SEASON:
+	default:
+		return board, errors.New("Unknown hiscore period")
+	}
+
+	var err error
+	if request.Season == "" {
+		board.season, err = dbTx.Queries.GetCurrentSeason(dbTx.Ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return board, errors.New("No season is running right now")
+		}
+	} else {
+		board.season, err = dbTx.Queries.GetSeasonByName(dbTx.Ctx, request.Season)
+		if errors.Is(err, sql.ErrNoRows) {
+			return board, errors.New("No season found with that name")
+		}
+	}
+	if err != nil {
+		logger.Errorf("Error getting season %q: %v", request.Season, err)
+		return board, errors.New("Failed to get season (internal server error) - please try again later")
+	}
+	return board, nil
+}
+
+// Which page of the board a request is for, given as the number of hiscores to skip and how many to send
+func hiscorePage(request *packets.HiscoreBoardRequestMessage) (int64, int64) {
+	limit := int64(min(request.Limit, uint64(maxHiscorePageSize)))
+	if limit == 0 {
+		limit = hiscorePageSize
+	}
+	return int64(min(request.Offset, math.MaxInt64)), limit
+}
+
 type BrowsingHiscores struct {
 	client  server.ClientInterfacer
-	logger  *log.Logger
+	logger  *logging.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+	board   hiscoreBoard
+	offset  int64
+	limit   int64
+
+	// How many players were on the board when it was last sent
+	total int64
 }
 
 func (b *BrowsingHiscores) Name() string {
@@ -22,22 +98,31 @@ func (b *BrowsingHiscores) Name() string {
 
 func (b *BrowsingHiscores) SetClient(client server.ClientInterfacer) {
 	b.client = client
-	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), b.Name())
-	b.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	b.logger = logger.With("client_id", client.Id(), "state", b.Name(), "account_id", client.AccountId())
 	b.queries = client.DbTx().Queries
 	b.dbCtx = client.DbTx().Ctx
 }
 
 func (b *BrowsingHiscores) OnEnter() {
-	b.sendTopScores(10, 0)
+	b.sendTopScores()
 }
 
 func (b *BrowsingHiscores) HandleMessage(senderId uint64, message packets.Msg) {
 	switch message := message.(type) {
 	case *packets.Packet_FinishedBrowsingHiscores:
 		b.handleFinishedBrowsingHiscoresMessage(senderId, message)
+	case *packets.Packet_HiscoreBoardRequest:
+		b.handleHiscoreBoardRequest(senderId, message)
 	case *packets.Packet_SearchHiscore:
 		b.handleSearchHiscore(senderId, message)
+	case *packets.Packet_SelectHiscore:
+		b.handleSelectHiscore(senderId, message)
+	case *packets.Packet_HiscorePageRequest:
+		b.handleHiscorePageRequest(senderId, message)
+	case *packets.Packet_StatsRequest:
+		b.handleStatsRequest(senderId, message)
+	case *packets.Packet_Chat:
+		b.handleChat(senderId, message)
 	}
 }
 
@@ -48,47 +133,347 @@ func (b *BrowsingHiscores) handleFinishedBrowsingHiscoresMessage(senderId uint64
 	b.client.SetState(&Connected{})
 }
 
+// Switches to another board, or another page of the same board, without leaving the state
+func (b *BrowsingHiscores) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
+	if senderId != b.client.Id() {
+		return
+	}
+
+	board, err := selectHiscoreBoard(b.client.DbTx(), message.HiscoreBoardRequest)
+	if err != nil {
+		b.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+
+	b.board = board
+	b.offset, b.limit = hiscorePage(message.HiscoreBoardRequest)
+	b.sendTopScores()
+}
+
+func (b *BrowsingHiscores) handleChat(senderId uint64, message *packets.Packet_Chat) {
+	if senderId == b.client.Id() {
+		denyLobbyChat(b.client)
+		return
+	}
+	forwardChat(b.client, senderId, message, false)
+}
+
+func (b *BrowsingHiscores) handleStatsRequest(senderId uint64, message *packets.Packet_StatsRequest) {
+	if senderId != b.client.Id() {
+		return
+	}
+
+	sendPlayerStats(b.client, message.StatsRequest.Name)
+}
+
+// Finds the players whose names best match the search, along with where they stand on the board being browsed, so
+// the client can pick one to jump to. Exact matches come first, then names starting with the search, then names
+// containing it, then names containing its letters in order.
 func (b *BrowsingHiscores) handleSearchHiscore(senderId uint64, message *packets.Packet_SearchHiscore) {
-	player, err := b.queries.GetPlayerByName(b.dbCtx, message.SearchHiscore.Name)
+	if senderId != b.client.Id() {
+		return
+	}
+
+	query := strings.TrimSpace(message.SearchHiscore.Name)
+	if query == "" {
+		b.client.SocketSend(packets.NewDenyResponse("Enter a name to search for"))
+		return
+	}
+	if utf8.RuneCountInString(query) > maxHiscoreSearchLength {
+		b.client.SocketSend(packets.NewDenyResponse("No players found matching that name"))
+		return
+	}
+
+	players, err := searchHiscorePlayers(b.dbCtx, b.queries, b.board, query, time.Now())
+	if err != nil {
+		b.logger.Errorf("Error searching for players matching %q: %v", query, err)
+		b.client.SocketSend(packets.NewDenyResponse("Failed to search hiscores (internal server error) - please try again later"))
+		return
+	}
+
+	matches := make([]*packets.HiscoreMessage, 0, len(players))
+	for _, player := range players {
+		rank, score, err := b.playerStanding(player)
+		if errors.Is(err, sql.ErrNoRows) {
+			// They haven't played during the period this board covers
+			continue
+		}
+		if err != nil {
+			b.logger.Errorf("Error getting standing of player %s: %v", player.Name, err)
+			b.client.SocketSend(packets.NewDenyResponse("Failed to search hiscores (internal server error) - please try again later"))
+			return
+		}
+
+		matches = append(matches, &packets.HiscoreMessage{
+			Rank:  uint64(rank),
+			Name:  player.Name,
+			Score: uint64(score),
+		})
+	}
+
+	if len(matches) == 0 {
+		b.client.SocketSend(packets.NewDenyResponse("No players found matching that name"))
+		return
+	}
+
+	b.client.SocketSend(packets.NewHiscoreSearchResults(query, matches))
+}
 
+// Jumps to the page of the board the player is on, with them in the middle of it
+func (b *BrowsingHiscores) handleSelectHiscore(senderId uint64, message *packets.Packet_SelectHiscore) {
+	if senderId != b.client.Id() {
+		return
+	}
+
+	// Players whose accounts are being deleted are left off the boards, so they can't be found here either
+	player, err := b.queries.GetRankedPlayerByExactName(b.dbCtx, message.SelectHiscore.Name)
 	if err != nil {
-		b.logger.Printf("Error getting player %s: %v", message.SearchHiscore.Name, err)
+		if !errors.Is(err, sql.ErrNoRows) {
+			b.logger.Errorf("Error getting player %s: %v", message.SelectHiscore.Name, err)
+		}
 		b.client.SocketSend(packets.NewDenyResponse("No player found with that name"))
 		return
 	}
 
-	playerRank, err := b.queries.GetPlayerRank(b.dbCtx, player.ID)
+	rank, _, err := b.playerStanding(player)
 	if err != nil {
-		b.logger.Printf("Error getting rank of player %s: %v", player.Name, err)
+		b.logger.Errorf("Error getting rank of player %s: %v", player.Name, err)
 		b.client.SocketSend(packets.NewDenyResponse("Player is unranked"))
 		return
 	}
 
-	const limit int64 = 10
-	offset := playerRank - limit/2
-	b.sendTopScores(limit, max(0, offset))
+	// Everyone sharing the player's rank comes after everyone ranked above them, so the first of them is always at
+	// this position
+	b.offset = max(0, rank-1-b.limit/2)
+	b.sendTopScores()
 }
 
-func (b *BrowsingHiscores) sendTopScores(limit, offset int64) {
-	topScores, err := b.queries.GetTopScores(b.dbCtx, db.GetTopScoresParams{
-		Limit:  limit,
-		Offset: offset,
+func (b *BrowsingHiscores) handleHiscorePageRequest(senderId uint64, message *packets.Packet_HiscorePageRequest) {
+	if senderId != b.client.Id() {
+		return
+	}
+
+	switch message.HiscorePageRequest.Direction {
+	case packets.HiscorePageDirection_HISCORE_PAGE_NEXT:
+		if b.offset+b.limit >= b.total {
+			b.client.SocketSend(packets.NewDenyResponse("You're already on the last page"))
+			return
+		}
+		b.offset += b.limit
+	case packets.HiscorePageDirection_HISCORE_PAGE_PREVIOUS:
+		if b.offset == 0 {
+			b.client.SocketSend(packets.NewDenyResponse("You're already on the first page"))
+			return
+		}
+		b.offset = max(0, b.offset-b.limit)
+	default:
+		b.client.SocketSend(packets.NewDenyResponse("Unknown page direction"))
+		return
+	}
+
+	b.sendTopScores()
+}
+
+// Where the player ranks on the board being browsed, and the score that puts them there
+func (b *BrowsingHiscores) playerStanding(player db.Player) (int64, int64, error) {
+	if b.board.period == packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME {
+		rank, err := b.queries.GetPlayerRank(b.dbCtx, player.ID)
+		return rank, player.BestScore, err
+	}
+
+	if b.board.season.EndedAt.Valid {
+		result, err := b.queries.GetSeasonResult(b.dbCtx, db.GetSeasonResultParams{
+			SeasonID: b.board.season.ID,
+			PlayerID: player.ID,
+		})
+		return result.Rank, result.Score, err
+	}
+
+	start, end, err := b.window()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	bestScore, err := b.queries.GetPlayerBestScoreBetween(b.dbCtx, db.GetPlayerBestScoreBetweenParams{
+		PlayerID:  player.ID,
+		EndedAt:   start.Unix(),
+		EndedAt_2: end.Unix(),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	beatenBy, err := b.queries.CountPlayersBeatingScoreBetween(b.dbCtx, db.CountPlayersBeatingScoreBetweenParams{
+		EndedAt:   start.Unix(),
+		EndedAt_2: end.Unix(),
+		Score:     bestScore,
+	})
+	return beatenBy + 1, bestScore, err
+}
+
+// Finds the players on the board whose names best match the query, best matches first. Only players with a standing on
+// the board are searched, so the results aren't used up by players who haven't played during the period it covers.
+func searchHiscorePlayers(ctx context.Context, queries *db.Queries, board hiscoreBoard, query string, now time.Time) ([]db.Player, error) {
+	fuzzy, exact, escaped := fuzzyLikePattern(query), query, escapeLike(query)
+
+	if board.period == packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME {
+		return queries.SearchPlayersByName(ctx, db.SearchPlayersByNameParams{
+			Name:   fuzzy,
+			Name_2: exact,
+			Name_3: escaped + "%",
+			Name_4: "%" + escaped + "%",
+			Limit:  maxHiscoreSearchResults,
+		})
+	}
+
+	if board.season.EndedAt.Valid {
+		return queries.SearchPlayersByNameInSeason(ctx, db.SearchPlayersByNameInSeasonParams{
+			Name:     fuzzy,
+			SeasonID: board.season.ID,
+			Name_2:   exact,
+			Name_3:   escaped + "%",
+			Name_4:   "%" + escaped + "%",
+			Limit:    maxHiscoreSearchResults,
+		})
+	}
+
+	start, end, err := board.window(now)
+	if err != nil {
+		return nil, err
+	}
+
+	return queries.SearchPlayersByNameBetween(ctx, db.SearchPlayersByNameBetweenParams{
+		Name:      fuzzy,
+		EndedAt:   start.Unix(),
+		EndedAt_2: end.Unix(),
+		Name_2:    exact,
+		Name_3:    escaped + "%",
+		Name_4:    "%" + escaped + "%",
+		Limit:     maxHiscoreSearchResults,
 	})
+}
+
+// Escapes the wildcards in text so a LIKE pattern matches it literally, using \ as the escape character
+func escapeLike(text string) string {
+	return likeEscaper.Replace(text)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// A LIKE pattern matching any name containing the query's letters in order, e.g. "bb" matches "Bob"
+func fuzzyLikePattern(query string) string {
+	var pattern strings.Builder
+	pattern.WriteString("%")
+	for _, r := range query {
+		pattern.WriteString(escapeLike(string(r)))
+		pattern.WriteString("%")
+	}
+	return pattern.String()
+}
+
+// The stretch of time the board covers at the given time, if it isn't the all-time board
+func (h hiscoreBoard) window(now time.Time) (time.Time, time.Time, error) {
+	if h.period == packets.HiscorePeriod_HISCORE_PERIOD_SEASON {
+		start, end := server.SeasonWindow(h.season, now)
+		return start, end, nil
+	}
+	return server.HiscorePeriodWindow(h.period, now)
+}
+
+// The stretch of time the board being browsed covers, if it isn't the all-time board
+func (b *BrowsingHiscores) window() (time.Time, time.Time, error) {
+	return b.board.window(time.Now())
+}
+
+// Sends the page of the board being browsed that the client is on
+func (b *BrowsingHiscores) sendTopScores() {
+	hiscoreMessages, total, err := b.getTopScores(b.limit, b.offset)
 	if err != nil {
-		b.logger.Printf("Error getting top %d scores from rank %d: %v", limit, offset, err)
+		b.logger.Errorf("Error getting top %d scores from rank %d: %v", b.limit, b.offset, err)
 		b.client.SocketSend(packets.NewDenyResponse("Failed to get top scores - please try again later"))
 		return
 	}
 
+	b.total = total
+	b.client.SocketSend(packets.NewHiscoreBoard(hiscoreMessages, b.board.period, b.board.season.Name, b.offset, total))
+}
+
+// Gets a page of the board being browsed, along with how many players are on the whole board. Players with the same
+// score share a rank, and the next rank down skips the places they share, e.g. 1, 2, 2, 4.
+func (b *BrowsingHiscores) getTopScores(limit, offset int64) ([]*packets.HiscoreMessage, int64, error) {
 	hiscoreMessages := make([]*packets.HiscoreMessage, 0, limit)
-	for rank, scoreRow := range topScores {
-		hiscoreMessage := &packets.HiscoreMessage{
-			Rank:  uint64(rank) + uint64(offset) + 1,
-			Name:  scoreRow.Name,
-			Score: uint64(scoreRow.BestScore),
+
+	switch {
+	case b.board.period == packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME:
+		topScores, err := b.queries.GetTopScores(b.dbCtx, db.GetTopScoresParams{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, scoreRow := range topScores {
+			hiscoreMessages = append(hiscoreMessages, &packets.HiscoreMessage{
+				Rank:  uint64(scoreRow.Rank),
+				Name:  scoreRow.Name,
+				Score: uint64(scoreRow.BestScore),
+			})
+		}
+
+		total, err := b.queries.CountRankedPlayers(b.dbCtx)
+		return hiscoreMessages, total, err
+
+	case b.board.season.EndedAt.Valid:
+		// The season's over, so its standings have been archived
+		results, err := b.queries.GetSeasonResults(b.dbCtx, db.GetSeasonResultsParams{
+			SeasonID: b.board.season.ID,
+			Limit:    limit,
+			Offset:   offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, result := range results {
+			hiscoreMessages = append(hiscoreMessages, &packets.HiscoreMessage{
+				Rank:  uint64(result.Rank),
+				Name:  result.Name,
+				Score: uint64(result.Score),
+			})
 		}
-		hiscoreMessages = append(hiscoreMessages, hiscoreMessage)
-	}
 
-	b.client.SocketSend(packets.NewHiscoreBoard(hiscoreMessages))
+		total, err := b.queries.CountSeasonResults(b.dbCtx, b.board.season.ID)
+		return hiscoreMessages, total, err
+
+	default:
+		start, end, err := b.window()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		topScores, err := b.queries.GetTopScoresBetween(b.dbCtx, db.GetTopScoresBetweenParams{
+			EndedAt:   start.Unix(),
+			EndedAt_2: end.Unix(),
+			Limit:     limit,
+			Offset:    offset,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, scoreRow := range topScores {
+			hiscoreMessages = append(hiscoreMessages, &packets.HiscoreMessage{
+				Rank:  uint64(scoreRow.Rank),
+				Name:  scoreRow.Name,
+				Score: uint64(scoreRow.BestScore),
+			})
+		}
+
+		total, err := b.queries.CountPlayersBetween(b.dbCtx, db.CountPlayersBetweenParams{
+			EndedAt:   start.Unix(),
+			EndedAt_2: end.Unix(),
+		})
+		return hiscoreMessages, total, err
+	}
 }
@@ -0,0 +1,330 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"slices"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// Opens a fresh database with the schema in the test's temporary directory
+func openHiscoreTestDb(t *testing.T) *db.Queries {
+	t.Helper()
+
+	dbPool, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "db.sqlite"))
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	t.Cleanup(func() { dbPool.Close() })
+
+	if err := server.InitializeDb(context.Background(), dbPool); err != nil {
+		t.Fatalf("initializing database: %v", err)
+	}
+	return db.New(dbPool)
+}
+
+// Creates a user with a player of the given name and best score, returning the player
+func createHiscoreTestPlayer(t *testing.T, queries *db.Queries, name string, bestScore int64) db.Player {
+	t.Helper()
+	ctx := context.Background()
+
+	user, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "user_" + name, PasswordHash: "hash"})
+	if err != nil {
+		t.Fatalf("creating user for %q: %v", name, err)
+	}
+	player, err := queries.CreatePlayer(ctx, db.CreatePlayerParams{UserID: user.ID, Name: name, Color: -1})
+	if err != nil {
+		t.Fatalf("creating player %q: %v", name, err)
+	}
+	if err := queries.UpdatePlayerBestScore(ctx, db.UpdatePlayerBestScoreParams{ID: player.ID, BestScore: bestScore}); err != nil {
+		t.Fatalf("setting best score of %q: %v", name, err)
+	}
+	player.BestScore = bestScore
+	return player
+}
+
+func playerNames(players []db.Player) []string {
+	names := make([]string, len(players))
+	for i, player := range players {
+		names[i] = player.Name
+	}
+	return names
+}
+
+func TestSearchHiscorePlayersAllTime(t *testing.T) {
+	queries := openHiscoreTestDb(t)
+	allTime := hiscoreBoard{period: packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME}
+
+	// Scores are chosen so that ordering by score alone would get every case below wrong
+	for name, score := range map[string]int64{
+		"Bob":     10,
+		"Bobby":   20,
+		"Bobcat":  30,
+		"Abobo":   40,
+		"Brobst":  50,
+		"Alice":   60,
+		"a_b":     1,
+		"axb":     100,
+		"50%off":  1,
+		"500":     100,
+		`back\sl`: 1,
+	} {
+		createHiscoreTestPlayer(t, queries, name, score)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"exact then prefix then contains then subsequence", "bob", []string{"Bob", "Bobcat", "Bobby", "Abobo", "Brobst"}},
+		{"exact match ignores case", "BOBBY", []string{"Bobby"}},
+		{"underscore is matched literally", "a_b", []string{"a_b"}},
+		{"percent is matched literally", "50%", []string{"50%off"}},
+		{"lone percent only matches names containing one", "%", []string{"50%off"}},
+		{"backslash is matched literally", `k\s`, []string{`back\sl`}},
+		{"no matches", "zed", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			players, err := searchHiscorePlayers(context.Background(), queries, allTime, test.query, time.Now())
+			if err != nil {
+				t.Fatalf("searching for %q: %v", test.query, err)
+			}
+			if got := playerNames(players); !slices.Equal(got, test.want) {
+				t.Errorf("searching for %q got %q, want %q", test.query, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSearchHiscorePlayersExcludesDeletedAccounts(t *testing.T) {
+	queries := openHiscoreTestDb(t)
+	ctx := context.Background()
+
+	createHiscoreTestPlayer(t, queries, "Carol", 10)
+	deleted := createHiscoreTestPlayer(t, queries, "Caroline", 20)
+	now := time.Now()
+	if err := queries.CreateAccountDeletion(ctx, db.CreateAccountDeletionParams{
+		UserID:      deleted.UserID,
+		RequestedAt: now.Unix(),
+		DeleteAfter: now.Add(time.Hour).Unix(),
+	}); err != nil {
+		t.Fatalf("requesting account deletion: %v", err)
+	}
+
+	board := hiscoreBoard{period: packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME}
+	players, err := searchHiscorePlayers(ctx, queries, board, "carol", now)
+	if err != nil {
+		t.Fatalf("searching: %v", err)
+	}
+	if got, want := playerNames(players), []string{"Carol"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// Nor can they be selected by their exact name
+	if _, err := queries.GetRankedPlayerByExactName(ctx, "caroline"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("selecting a player whose account is being deleted got %v, want %v", err, sql.ErrNoRows)
+	}
+	if _, err := queries.GetRankedPlayerByExactName(ctx, "carol"); err != nil {
+		t.Errorf("selecting a ranked player got %v", err)
+	}
+}
+
+func TestSearchHiscorePlayersFiltersByPeriodBeforeLimiting(t *testing.T) {
+	queries := openHiscoreTestDb(t)
+	ctx := context.Background()
+	now := time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC)
+
+	// More matching players than a search returns, all better matches than the player on the board, none of whom have
+	// played in the period
+	for i := range maxHiscoreSearchResults + 5 {
+		createHiscoreTestPlayer(t, queries, fmt.Sprintf("Dave%02d", i), 1000)
+	}
+	played := createHiscoreTestPlayer(t, queries, "Big Dave", 5)
+	stale := createHiscoreTestPlayer(t, queries, "Old Dave", 5)
+
+	for _, history := range []db.CreateScoreHistoryParams{
+		{PlayerID: played.ID, Score: 5, StartedAt: now.Add(-time.Hour).Unix(), EndedAt: now.Add(-time.Minute).Unix()},
+		{PlayerID: stale.ID, Score: 5, StartedAt: now.AddDate(0, -2, 0).Unix(), EndedAt: now.AddDate(0, -2, 0).Unix()},
+	} {
+		if err := queries.CreateScoreHistory(ctx, history); err != nil {
+			t.Fatalf("creating score history: %v", err)
+		}
+	}
+
+	for _, period := range []packets.HiscorePeriod{
+		packets.HiscorePeriod_HISCORE_PERIOD_DAILY,
+		packets.HiscorePeriod_HISCORE_PERIOD_WEEKLY,
+		packets.HiscorePeriod_HISCORE_PERIOD_MONTHLY,
+	} {
+		t.Run(period.String(), func(t *testing.T) {
+			players, err := searchHiscorePlayers(ctx, queries, hiscoreBoard{period: period}, "dave", now)
+			if err != nil {
+				t.Fatalf("searching: %v", err)
+			}
+			if got, want := playerNames(players), []string{"Big Dave"}; !slices.Equal(got, want) {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSearchHiscorePlayersInEndedSeason(t *testing.T) {
+	queries := openHiscoreTestDb(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	season, err := queries.CreateSeason(ctx, db.CreateSeasonParams{Name: "Spring", StartedAt: now.AddDate(0, -1, 0).Unix()})
+	if err != nil {
+		t.Fatalf("creating season: %v", err)
+	}
+	season.EndedAt = sql.NullInt64{Int64: now.Add(-time.Hour).Unix(), Valid: true}
+
+	for i := range maxHiscoreSearchResults + 5 {
+		createHiscoreTestPlayer(t, queries, fmt.Sprintf("Erin%02d", i), 1000)
+	}
+	ranked := createHiscoreTestPlayer(t, queries, "Sir Erin", 5)
+	if err := queries.CreateSeasonResult(ctx, db.CreateSeasonResultParams{
+		SeasonID: season.ID,
+		PlayerID: ranked.ID,
+		Name:     ranked.Name,
+		Score:    5,
+		Rank:     1,
+	}); err != nil {
+		t.Fatalf("creating season result: %v", err)
+	}
+
+	board := hiscoreBoard{period: packets.HiscorePeriod_HISCORE_PERIOD_SEASON, season: season}
+	players, err := searchHiscorePlayers(ctx, queries, board, "erin", now)
+	if err != nil {
+		t.Fatalf("searching: %v", err)
+	}
+	if got, want := playerNames(players), []string{"Sir Erin"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+type hiscoreTestRow struct {
+	rank  uint64
+	name  string
+	score uint64
+}
+
+func TestBrowsingHiscoresRanks(t *testing.T) {
+	queries := openHiscoreTestDb(t)
+	ctx := context.Background()
+	now := time.Now()
+	longAgo := now.AddDate(0, 0, -40)
+
+	// Each player's all-time best, with the scores they got today and 40 days ago, which no calendar period covers both
+	// of. Names differ in case so ties have to be broken ignoring it, e.g. bob comes before Cat.
+	players := []struct {
+		name    string
+		best    int64
+		today   []int64
+		longAgo []int64
+		deleted bool
+	}{
+		{name: "Fay", best: 10, today: []int64{10}},
+		{name: "dan", best: 80, today: []int64{80}},
+		{name: "Ann", best: 100, today: []int64{20, 100}, longAgo: []int64{999}},
+		{name: "Cat", best: 80, today: []int64{80}},
+		{name: "Gus", best: 500, longAgo: []int64{500}},
+		{name: "Eve", best: 50, today: []int64{50}},
+		{name: "bob", best: 80, today: []int64{30, 80}},
+		{name: "Zed", best: 90, today: []int64{90}, deleted: true},
+	}
+	for _, p := range players {
+		player := createHiscoreTestPlayer(t, queries, p.name, p.best)
+		for _, scores := range []struct {
+			at     time.Time
+			scores []int64
+		}{{now, p.today}, {longAgo, p.longAgo}} {
+			for _, score := range scores.scores {
+				if err := queries.CreateScoreHistory(ctx, db.CreateScoreHistoryParams{
+					PlayerID:  player.ID,
+					Score:     score,
+					StartedAt: scores.at.Unix(),
+					EndedAt:   scores.at.Unix(),
+				}); err != nil {
+					t.Fatalf("creating score history: %v", err)
+				}
+			}
+		}
+		if p.deleted {
+			if err := queries.CreateAccountDeletion(ctx, db.CreateAccountDeletionParams{
+				UserID:      player.UserID,
+				RequestedAt: now.Unix(),
+				DeleteAfter: now.Add(time.Hour).Unix(),
+			}); err != nil {
+				t.Fatalf("requesting account deletion: %v", err)
+			}
+		}
+	}
+
+	tests := []struct {
+		period packets.HiscorePeriod
+		want   []hiscoreTestRow
+	}{
+		{packets.HiscorePeriod_HISCORE_PERIOD_ALL_TIME, []hiscoreTestRow{
+			{1, "Gus", 500}, {2, "Ann", 100}, {3, "bob", 80}, {3, "Cat", 80}, {3, "dan", 80}, {6, "Eve", 50}, {7, "Fay", 10},
+		}},
+		{packets.HiscorePeriod_HISCORE_PERIOD_DAILY, []hiscoreTestRow{
+			{1, "Ann", 100}, {2, "bob", 80}, {2, "Cat", 80}, {2, "dan", 80}, {5, "Eve", 50}, {6, "Fay", 10},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.period.String(), func(t *testing.T) {
+			b := &BrowsingHiscores{queries: queries, dbCtx: ctx, board: hiscoreBoard{period: test.period}}
+
+			// Pages split the tied players, and the last page is partly empty
+			const pageSize = 4
+			var got []hiscoreTestRow
+			for offset := int64(0); offset < int64(len(test.want))+pageSize; offset += pageSize {
+				page, total, err := b.getTopScores(pageSize, offset)
+				if err != nil {
+					t.Fatalf("getting page at offset %d: %v", offset, err)
+				}
+				if total != int64(len(test.want)) {
+					t.Errorf("page at offset %d has total %d, want %d", offset, total, len(test.want))
+				}
+				if len(page) > pageSize {
+					t.Errorf("page at offset %d has %d rows, want at most %d", offset, len(page), pageSize)
+				}
+				for _, row := range page {
+					got = append(got, hiscoreTestRow{row.Rank, row.Name, row.Score})
+				}
+			}
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("got board %v, want %v", got, test.want)
+			}
+
+			// A single player's standing is worked out separately from the pages, and has to agree with them
+			for _, want := range test.want {
+				player, err := queries.GetPlayerByExactName(ctx, want.name)
+				if err != nil {
+					t.Fatalf("getting player %q: %v", want.name, err)
+				}
+				rank, score, err := b.playerStanding(player)
+				if err != nil {
+					t.Fatalf("getting standing of %q: %v", want.name, err)
+				}
+				if uint64(rank) != want.rank || uint64(score) != want.score {
+					t.Errorf("%q stands at rank %d with %d, want rank %d with %d", want.name, rank, score, want.rank, want.score)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// How many of the most recent public chat messages are replayed to players when they join the game
+const chatReplayLength = 20
+
+// Passes a chat message from another client on to our own client, if it's on a channel they can see from their
+// current state. Only players in the game can see arena chat, but everyone can see global chat and system messages.
+func forwardChat(client server.ClientInterfacer, senderId uint64, message *packets.Packet_Chat, inGame bool) {
+	switch message.Chat.Channel {
+	case packets.ChatChannel_CHAT_CHANNEL_GLOBAL, packets.ChatChannel_CHAT_CHANNEL_WHISPER:
+		client.SocketSendAs(message, senderId)
+	case packets.ChatChannel_CHAT_CHANNEL_ARENA:
+		if inGame {
+			client.SocketSendAs(message, senderId)
+		}
+	case packets.ChatChannel_CHAT_CHANNEL_SYSTEM:
+		// System messages are relayed by whichever client triggered them, but come from the server
+		client.SocketSendAs(message, 0)
+	}
+}
+
+// Chat sent by a client which isn't logged in has nobody to answer for it, so it's not allowed. Clients in the lobby
+// states still receive chat, so they can see what's going on before joining.
+func denyLobbyChat(client server.ClientInterfacer) {
+	client.SocketSend(packets.NewDenyResponse("You need to log in to chat"))
+}
+
+// Rebuilds a chat message from its record in the database, so it can be sent to a client again
+func chatFromRecord(record db.ChatMessage) *packets.Packet_Chat {
+	return &packets.Packet_Chat{
+		Chat: &packets.ChatMessage{
+			Msg:        record.Msg,
+			Channel:    packets.ChatChannel(record.Channel),
+			Target:     record.Target,
+			SenderName: record.SenderName,
+			SentAt:     record.SentAt,
+		},
+	}
+}
+
+func newChatLogEntry(record db.ChatMessage) *packets.ChatLogEntryMessage {
+	return &packets.ChatLogEntryMessage{
+		Id:         uint64(record.ID),
+		SenderName: record.SenderName,
+		Channel:    packets.ChatChannel(record.Channel),
+		Arena:      record.Arena,
+		Target:     record.Target,
+		Msg:        record.Msg,
+		SentAt:     record.SentAt,
+	}
+}
+
+// Whether one player (by database ID) has blocked another
+func hasBlocked(dbTx *server.DbTx, playerId, otherId int64) (bool, error) {
+	count, err := dbTx.Queries.IsPlayerBlocked(dbTx.Ctx, db.IsPlayerBlockedParams{
+		PlayerID:        playerId,
+		BlockedPlayerID: otherId,
+	})
+	return count > 0, err
+}
+
+// A thread-safe copy of the players our player has blocked, keyed by database ID, with the names they had when loaded
+type blockList struct {
+	names map[int64]string
+	mux   sync.Mutex
+}
+
+func newBlockList() *blockList {
+	return &blockList{names: make(map[int64]string)}
+}
+
+func (l *blockList) add(playerId int64, name string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.names[playerId] = name
+}
+
+func (l *blockList) remove(playerId int64) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	delete(l.names, playerId)
+}
+
+func (l *blockList) contains(playerId int64) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	_, exists := l.names[playerId]
+	return exists
+}
+
+// Whether a blocked player had the given name (case-insensitive) when they were loaded into the list
+func (l *blockList) containsName(name string) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	for _, blockedName := range l.names {
+		if strings.EqualFold(blockedName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *blockList) len() int {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return len(l.names)
+}
+
+// The names of the blocked players in alphabetical order
+func (l *blockList) list() []string {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	names := make([]string, 0, len(l.names))
+	for _, name := range l.names {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return names
+}
@@ -0,0 +1,575 @@
+package states
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Returned by a command when it's been given the wrong arguments, so the player is shown how to use it
+var errCommandUsage = errors.New("wrong arguments")
+
+// A slash command players can type into the chat while they're in the game
+type chatCommand struct {
+	name  string
+	usage string
+	help  string
+
+	// Whether the command needs the permission below, or anyone can use it
+	restricted bool
+	permission server.Permission
+
+	// Whether the command sends a chat message, which counts against the chat rate limit when it's sent, so running
+	// the command doesn't need to as well
+	sendsChat bool
+
+	// Runs the command and returns the lines of output to show our player. Errors are shown to them as they are.
+	run func(g *InGame, args []string) ([]string, error)
+}
+
+// Every command, keyed by its name and any aliases, without the leading slash
+var chatCommands = make(map[string]*chatCommand)
+
+func registerChatCommand(command *chatCommand, aliases ...string) {
+	chatCommands[command.name] = command
+	for _, alias := range aliases {
+		chatCommands[alias] = command
+	}
+}
+
+func init() {
+	registerChatCommand(&chatCommand{
+		name:  "help",
+		usage: "/help",
+		help:  "List the commands you can use",
+		run:   (*InGame).commandHelp,
+	})
+	registerChatCommand(&chatCommand{
+		name:  "who",
+		usage: "/who",
+		help:  "List the players in the game",
+		run:   (*InGame).commandWho,
+	})
+	registerChatCommand(&chatCommand{
+		name:  "rank",
+		usage: "/rank [name]",
+		help:  "Show your hiscore rank, or someone else's",
+		run:   (*InGame).commandRank,
+	})
+	registerChatCommand(&chatCommand{
+		name:  "stats",
+		usage: "/stats [name]",
+		help:  "Show your stats, or someone else's",
+		run:   (*InGame).commandStats,
+	})
+	registerChatCommand(&chatCommand{
+		name:      "whisper",
+		usage:     "/whisper <name> <message>",
+		help:      "Send a private message to a player in the game",
+		sendsChat: true,
+		run:       (*InGame).commandWhisper,
+	}, "w")
+	registerChatCommand(&chatCommand{
+		name:  "block",
+		usage: "/block [name]",
+		help:  "Hide a player's messages and stop them whispering to you, or list who you've blocked",
+		run:   (*InGame).commandBlock,
+	}, "ignore")
+	registerChatCommand(&chatCommand{
+		name:  "unblock",
+		usage: "/unblock <name>",
+		help:  "Let a blocked player's messages through again",
+		run:   (*InGame).commandUnblock,
+	}, "unignore")
+	registerChatCommand(&chatCommand{
+		name:  "friends",
+		usage: "/friends",
+		help:  "List your friends and who's online, along with any friend requests",
+		run:   (*InGame).commandFriends,
+	})
+	registerChatCommand(&chatCommand{
+		name:  "friend",
+		usage: "/friend <name>",
+		help:  "Send a player a friend request, or accept theirs",
+		run:   (*InGame).commandFriend,
+	})
+	registerChatCommand(&chatCommand{
+		name:  "unfriend",
+		usage: "/unfriend <name>",
+		help:  "Remove a friend, or decline or cancel a friend request",
+		run:   (*InGame).commandUnfriend,
+	})
+	registerChatCommand(&chatCommand{
+		name:  "join",
+		usage: "/join <name>",
+		help:  "Move to the arena a friend is playing in",
+		run:   (*InGame).commandJoin,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "kick",
+		usage:      "/kick <name> [reason]",
+		help:       "Disconnect a player from the game",
+		restricted: true,
+		permission: server.PermissionKick,
+		run:        (*InGame).commandKick,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "mute",
+		usage:      "/mute <name> <duration|permanent> [reason]",
+		help:       "Stop a player from chatting, e.g. /mute bob 1h spamming",
+		restricted: true,
+		permission: server.PermissionMute,
+		run:        (*InGame).commandMute,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "unmute",
+		usage:      "/unmute <name>",
+		help:       "Let a muted player chat again",
+		restricted: true,
+		permission: server.PermissionMute,
+		run:        (*InGame).commandUnmute,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "ban",
+		usage:      "/ban <name> <duration|permanent> [reason]",
+		help:       "Stop a player from logging in, e.g. /ban bob 7d cheating",
+		restricted: true,
+		permission: server.PermissionBan,
+		run:        (*InGame).commandBan,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "unban",
+		usage:      "/unban <name>",
+		help:       "Let a banned player log in again",
+		restricted: true,
+		permission: server.PermissionBan,
+		run:        (*InGame).commandUnban,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "chatlog",
+		usage:      "/chatlog <name|*> [period]",
+		help:       "Show recent chat from a player, or everyone, e.g. /chatlog bob 1h",
+		restricted: true,
+		permission: server.PermissionViewChatLog,
+		run:        (*InGame).commandChatLog,
+	})
+	registerChatCommand(&chatCommand{
+		name:       "announce",
+		usage:      "/announce <message>",
+		help:       "Send a system message to everyone online",
+		restricted: true,
+		permission: server.PermissionAnnounce,
+		run:        (*InGame).commandAnnounce,
+	})
+}
+
+// Interprets a chat message starting with a slash as a command, and sends the output back to our client only
+func (g *InGame) runChatCommand(text string) {
+	fields := strings.Fields(strings.TrimPrefix(text, "/"))
+	var name string
+	var command *chatCommand
+	if len(fields) > 0 {
+		name = strings.ToLower(fields[0])
+		command = chatCommands[name]
+	}
+
+	// Commands can be spammed as easily as chat, so they're rate limited the same way
+	if command == nil || !command.sendsChat {
+		if muteFor, err := g.client.ChatFilter().CheckCommand(g.client.AccountId()); err != nil {
+			if muteFor > 0 {
+				err = g.muteForFlooding(muteFor)
+			} else {
+				err = fmt.Errorf("Command not run: %w", err)
+			}
+			g.client.SocketSend(packets.NewCommandResponse(name, false, []string{err.Error()}))
+			return
+		}
+	}
+
+	if len(fields) == 0 {
+		g.client.SocketSend(packets.NewCommandResponse("", false, []string{"Type /help for a list of commands"}))
+		return
+	}
+	if command == nil {
+		g.client.SocketSend(packets.NewCommandResponse(name, false, []string{fmt.Sprintf("Unknown command /%s - type /help for a list of commands", name)}))
+		return
+	}
+
+	if command.restricted {
+		if err := server.CheckPermission(g.client, command.permission); err != nil {
+			g.logger.Printf("Denied command /%s: %v", command.name, err)
+			g.client.SocketSend(packets.NewCommandResponse(command.name, false, []string{"You don't have permission to do that"}))
+			return
+		}
+	}
+
+	lines, err := command.run(g, fields[1:])
+	if errors.Is(err, errCommandUsage) {
+		lines = []string{fmt.Sprintf("Usage: %s", command.usage)}
+	} else if err != nil {
+		lines = []string{err.Error()}
+	}
+	g.client.SocketSend(packets.NewCommandResponse(command.name, err == nil, lines))
+}
+
+func (g *InGame) commandHelp(args []string) ([]string, error) {
+	role, err := server.GetUserRole(g.client.DbTx(), g.client.AccountId())
+	if err != nil {
+		g.logger.Errorf("Error getting role to list commands, only listing unrestricted ones: %v", err)
+		role = server.RolePlayer
+	}
+
+	var lines []string
+	for name, command := range chatCommands {
+		// Skip aliases so each command is only listed once
+		if name != command.name || (command.restricted && !role.Can(command.permission)) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s - %s", command.usage, command.help))
+	}
+	slices.Sort(lines)
+	return lines, nil
+}
+
+func (g *InGame) commandWho(args []string) ([]string, error) {
+	type entry struct {
+		name string
+		mass int64
+	}
+
+	var entries []entry
+	g.client.SharedGameObjects().Players.ForEach(func(_ uint64, player *objects.Player) {
+		entries = append(entries, entry{player.Name, int64(math.Round(radToMass(player.Radius)))})
+	})
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name))
+	})
+
+	lines := []string{fmt.Sprintf("%d player(s) in the game:", len(entries))}
+	for _, e := range entries {
+		lines = append(lines, fmt.Sprintf("%s (mass %d)", e.name, e.mass))
+	}
+	return lines, nil
+}
+
+func (g *InGame) commandRank(args []string) ([]string, error) {
+	if len(args) > 1 {
+		return nil, errCommandUsage
+	}
+
+	name, playerId, bestScore := g.player.Name, g.player.DbId, g.player.BestScore
+	if len(args) == 1 {
+		player, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, args[0])
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("No player found with that name")
+		}
+		if err != nil {
+			g.logger.Errorf("Error getting player %s to rank: %v", args[0], err)
+			return nil, errors.New("Failed to get rank (internal server error) - please try again later")
+		}
+
+		// Players whose accounts are being deleted are left off the hiscores, so they don't have a rank
+		if _, err := g.client.DbTx().Queries.GetAccountDeletion(g.client.DbTx().Ctx, player.UserID); err == nil {
+			return nil, errors.New("No player found with that name")
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			g.logger.Errorf("Error checking if player %s is being deleted: %v", player.Name, err)
+			return nil, errors.New("Failed to get rank (internal server error) - please try again later")
+		}
+		name, playerId, bestScore = player.Name, player.ID, player.BestScore
+	}
+
+	rank, err := g.client.DbTx().Queries.GetPlayerRank(g.client.DbTx().Ctx, playerId)
+	if err != nil {
+		g.logger.Errorf("Error getting rank of player %s: %v", name, err)
+		return nil, errors.New("Failed to get rank (internal server error) - please try again later")
+	}
+
+	return []string{fmt.Sprintf("%s is ranked #%d with a best score of %d", name, rank, bestScore)}, nil
+}
+
+func (g *InGame) commandStats(args []string) ([]string, error) {
+	if len(args) > 1 {
+		return nil, errCommandUsage
+	}
+
+	name := g.player.Name
+	if len(args) == 1 {
+		name = args[0]
+	}
+
+	stats, err := playerStats(g.client, name)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{fmt.Sprintf("Stats for %s:", stats.Name)}
+	if stats.Online {
+		lines = append(lines, "This session: "+describeStatsTotals(stats.Session))
+	}
+	lines = append(lines, fmt.Sprintf("Lifetime (%d sessions): %s", stats.Lifetime.Sessions, describeStatsTotals(stats.Lifetime)))
+	if len(stats.MostEaten) > 0 {
+		lines = append(lines, "Eaten most: "+describeKillCounts(stats.MostEaten))
+	}
+	if len(stats.MostEatenBy) > 0 {
+		lines = append(lines, "Eaten most by: "+describeKillCounts(stats.MostEatenBy))
+	}
+	return lines, nil
+}
+
+func (g *InGame) commandWhisper(args []string) ([]string, error) {
+	if len(args) < 2 {
+		return nil, errCommandUsage
+	}
+
+	chat := &packets.ChatMessage{
+		Msg:     strings.Join(args[1:], " "),
+		Channel: packets.ChatChannel_CHAT_CHANNEL_WHISPER,
+		Target:  args[0],
+	}
+	if err := g.sendChat(chat); err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("To %s: %s", chat.Target, chat.Msg)}, nil
+}
+
+func (g *InGame) commandBlock(args []string) ([]string, error) {
+	switch len(args) {
+	case 0:
+		names := g.blocked.list()
+		if len(names) == 0 {
+			return []string{"You haven't blocked anyone"}, nil
+		}
+		return append([]string{"You have blocked:"}, names...), nil
+	case 1:
+		name, err := g.blockPlayer(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("You have blocked %s", name)}, nil
+	default:
+		return nil, errCommandUsage
+	}
+}
+
+func (g *InGame) commandUnblock(args []string) ([]string, error) {
+	if len(args) != 1 {
+		return nil, errCommandUsage
+	}
+
+	name, err := g.unblockPlayer(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("You have unblocked %s", name)}, nil
+}
+
+func (g *InGame) commandFriends(args []string) ([]string, error) {
+	if len(args) != 0 {
+		return nil, errCommandUsage
+	}
+
+	queries, ctx := g.client.DbTx().Queries, g.client.DbTx().Ctx
+	friends, err := queries.GetFriends(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting friends: %v", err)
+		return nil, errors.New("Failed to get friends (internal server error) - please try again later")
+	}
+	incoming, err := queries.GetIncomingFriendRequests(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting incoming friend requests: %v", err)
+		return nil, errors.New("Failed to get friends (internal server error) - please try again later")
+	}
+
+	var lines []string
+	if len(friends) == 0 {
+		lines = append(lines, "You haven't added any friends")
+	} else {
+		lines = append(lines, "Your friends:")
+	}
+	for _, friend := range friends {
+		presence := g.friendPresence(friend.ID, friend.Name)
+		if presence.Online {
+			lines = append(lines, fmt.Sprintf("%s (online in %s)", presence.Name, presence.Arena))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s (offline)", presence.Name))
+		}
+	}
+
+	for _, request := range incoming {
+		lines = append(lines, fmt.Sprintf("%s wants to be your friend - /friend %s to accept", request.Name, request.Name))
+	}
+	return lines, nil
+}
+
+func (g *InGame) commandFriend(args []string) ([]string, error) {
+	if len(args) != 1 {
+		return nil, errCommandUsage
+	}
+
+	outcome, err := g.addFriend(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return []string{outcome}, nil
+}
+
+func (g *InGame) commandUnfriend(args []string) ([]string, error) {
+	if len(args) != 1 {
+		return nil, errCommandUsage
+	}
+
+	outcome, err := g.removeFriend(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return []string{outcome}, nil
+}
+
+func (g *InGame) commandJoin(args []string) ([]string, error) {
+	if len(args) != 1 {
+		return nil, errCommandUsage
+	}
+
+	if err := g.joinFriend(args[0]); err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("Joined %s's arena", args[0])}, nil
+}
+
+func (g *InGame) commandKick(args []string) ([]string, error) {
+	if len(args) < 1 {
+		return nil, errCommandUsage
+	}
+
+	reason := commandReason(args[1:])
+	if err := g.kickPlayer(args[0], reason); err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("Kicked %s: %s", args[0], reason)}, nil
+}
+
+func (g *InGame) commandMute(args []string) ([]string, error) {
+	if len(args) < 2 {
+		return nil, errCommandUsage
+	}
+
+	duration, err := server.ParseSanctionDuration(args[1])
+	if err != nil {
+		return nil, errCommandUsage
+	}
+
+	reason := commandReason(args[2:])
+	if err := g.mutePlayer(args[0], reason, duration); err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("Muted %s (%s): %s", args[0], args[1], reason)}, nil
+}
+
+func (g *InGame) commandUnmute(args []string) ([]string, error) {
+	if len(args) != 1 {
+		return nil, errCommandUsage
+	}
+
+	description, err := g.revokeSanctions(args[0], server.SanctionMute)
+	if err != nil {
+		return nil, err
+	}
+	return []string{description}, nil
+}
+
+func (g *InGame) commandBan(args []string) ([]string, error) {
+	if len(args) < 2 {
+		return nil, errCommandUsage
+	}
+
+	duration, err := server.ParseSanctionDuration(args[1])
+	if err != nil {
+		return nil, errCommandUsage
+	}
+
+	reason := commandReason(args[2:])
+	if err := g.banPlayer(args[0], reason, duration, false); err != nil {
+		return nil, err
+	}
+	return []string{fmt.Sprintf("Banned %s (%s): %s", args[0], args[1], reason)}, nil
+}
+
+func (g *InGame) commandUnban(args []string) ([]string, error) {
+	if len(args) != 1 {
+		return nil, errCommandUsage
+	}
+
+	description, err := g.revokeSanctions(args[0], server.SanctionBan)
+	if err != nil {
+		return nil, err
+	}
+	return []string{description}, nil
+}
+
+// How many messages /chatlog shows, since they're going to the chat box rather than a proper moderation screen
+const chatLogCommandLimit = 10
+
+func (g *InGame) commandChatLog(args []string) ([]string, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, errCommandUsage
+	}
+
+	name := args[0]
+	if name == "*" {
+		name = ""
+	}
+
+	var since time.Time
+	if len(args) == 2 {
+		period, err := server.ParseSanctionDuration(args[1])
+		if err != nil || period <= 0 {
+			return nil, errCommandUsage
+		}
+		since = time.Now().Add(-period)
+	}
+
+	records, err := g.searchChat(name, since, time.Time{}, chatLogCommandLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return []string{"No chat messages found"}, nil
+	}
+
+	lines := make([]string, 0, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		record := records[i]
+		sender := record.SenderName
+		if record.Target != "" {
+			sender = fmt.Sprintf("%s -> %s", record.SenderName, record.Target)
+		}
+		channel := strings.ToLower(strings.TrimPrefix(packets.ChatChannel(record.Channel).String(), "CHAT_CHANNEL_"))
+		sentAt := time.Unix(record.SentAt, 0).UTC().Format(time.DateTime)
+		lines = append(lines, fmt.Sprintf("[%s] [%s] %s: %s", sentAt, channel, sender, record.Msg))
+	}
+	return lines, nil
+}
+
+func (g *InGame) commandAnnounce(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errCommandUsage
+	}
+
+	msg := strings.Join(args, " ")
+	g.announce(msg)
+	return []string{fmt.Sprintf("Announced: %s", msg)}, nil
+}
+
+func commandReason(args []string) string {
+	if len(args) == 0 {
+		return "No reason given"
+	}
+	return strings.Join(args, " ")
+}
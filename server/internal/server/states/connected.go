@@ -2,21 +2,35 @@ package states
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"server/internal/server"
 	"server/internal/server/db"
-	"server/internal/server/objects"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
 	"server/pkg/packets"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Where the states log anything that isn't about a particular client
+var logger = logging.Subsystem("states")
+
+const (
+	// How long a player must wait after changing their display name before they can change it again
+	nameChangeCooldown = 24 * time.Hour
+
+	// How long after requesting deletion an account is kept around, in case the user changes their mind
+	accountDeletionGracePeriod = 7 * 24 * time.Hour
+)
+
 type Connected struct {
 	client  server.ClientInterfacer
-	logger  *log.Logger
+	logger  *logging.Logger
 	queries *db.Queries
 	dbCtx   context.Context
 }
@@ -27,13 +41,14 @@ func (c *Connected) Name() string {
 
 func (c *Connected) SetClient(client server.ClientInterfacer) {
 	c.client = client
-	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), c.Name())
-	c.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	c.logger = logger.With("client_id", client.Id(), "state", c.Name())
 	c.queries = client.DbTx().Queries
 	c.dbCtx = client.DbTx().Ctx
 }
 
 func (c *Connected) OnEnter() {
+	// Anyone in this state has either just connected or logged out, so they no longer hold any account
+	c.client.ReleaseAccount()
 	c.client.SocketSend(packets.NewId(c.client.Id()))
 }
 
@@ -45,6 +60,16 @@ func (c *Connected) HandleMessage(senderId uint64, message packets.Msg) {
 		c.handleRegisterRequest(senderId, message)
 	case *packets.Packet_HiscoreBoardRequest:
 		c.handleHiscoreBoardRequest(senderId, message)
+	case *packets.Packet_EditProfileRequest:
+		c.handleEditProfileRequest(senderId, message)
+	case *packets.Packet_DeleteAccountRequest:
+		c.handleDeleteAccountRequest(senderId, message)
+	case *packets.Packet_ExportDataRequest:
+		c.handleExportDataRequest(senderId, message)
+	case *packets.Packet_AchievementsRequest:
+		c.handleAchievementsRequest(senderId, message)
+	case *packets.Packet_Chat:
+		c.handleChat(senderId, message)
 	}
 }
 
@@ -53,7 +78,7 @@ func (c *Connected) OnExit() {
 
 func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_LoginRequest) {
 	if senderId != c.client.Id() {
-		c.logger.Printf("Received login request from another client (Id %d)", senderId)
+		c.logger.Warnf("Received login request from another client (Id %d)", senderId)
 		return
 	}
 
@@ -61,43 +86,68 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 
 	genericFailMessage := packets.NewDenyResponse("Incorrect username or password")
 
-	user, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username))
+	user, err := c.authenticate(username, message.LoginRequest.Password)
 	if err != nil {
-		c.logger.Printf("Error getting user by username: %v", err)
+		c.logger.Warnf("Failed to authenticate user %s: %v", username, err)
 		c.client.SocketSend(genericFailMessage)
+		metrics.Logins.Inc("bad_credentials")
 		return
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(message.LoginRequest.Password))
-	if err != nil {
-		c.logger.Printf("Incorrect password for user %s", username)
-		c.client.SocketSend(genericFailMessage)
+	if !c.checkNotBanned(user.ID) {
+		metrics.Logins.Inc("banned")
 		return
 	}
 
 	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
 	if err != nil {
-		c.logger.Printf("Error getting player for user %s: %v", username, err)
+		c.logger.Errorf("Error getting player for user %s: %v", username, err)
 		c.client.SocketSend(genericFailMessage)
+		metrics.Logins.Inc("error")
 		return
 	}
 
+	if err := c.client.ClaimAccount(user.ID); err != nil {
+		c.logger.Printf("Not letting user %s log in: %v", username, err)
+		c.client.SocketSend(packets.NewDenyResponse("This account is already logged in"))
+		metrics.Logins.Inc("account_in_use")
+		return
+	}
+
+	err = c.queries.CreateLoginHistory(c.dbCtx, db.CreateLoginHistoryParams{
+		UserID:     user.ID,
+		IpAddress:  c.client.IpAddress(),
+		LoggedInAt: time.Now().Unix(),
+	})
+	if err != nil {
+		c.logger.Errorf("Error recording login of user %s: %v", username, err)
+	}
+
+	// Logging back in during the grace period means the user changed their mind about deleting their account
+	if _, err := c.queries.GetAccountDeletion(c.dbCtx, user.ID); err == nil {
+		if err := c.queries.DeleteAccountDeletion(c.dbCtx, user.ID); err != nil {
+			c.logger.Errorf("Error cancelling deletion of user %s: %v", username, err)
+		} else {
+			c.logger.Printf("Cancelled pending deletion of user %s", username)
+		}
+	}
+
 	c.logger.Printf("User %s logged in successfully!", username)
 	c.client.SocketSend(packets.NewOkResponse())
+	metrics.Logins.Inc("success")
 
 	c.client.SetState(&InGame{
-		player: &objects.Player{
-			Name:      player.Name,
-			DbId:      player.ID,
-			BestScore: player.BestScore,
-			Color:     int32(player.Color),
-		},
+		player: newPlayerObject(player),
 	})
 }
 
 func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Packet_RegisterRequest) {
 	if senderId != c.client.Id() {
-		c.logger.Printf("Received register request from another client (Id %d)", senderId)
+		c.logger.Warnf("Received register request from another client (Id %d)", senderId)
+		return
+	}
+
+	if !c.checkNotBanned(0) {
 		return
 	}
 
@@ -111,29 +161,57 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 		return
 	}
 
+	// New players start at level 1, so they get whichever of the colors everyone starts with is closest to the one
+	// they picked. The client lets them pick any color, since it can't know the palette before they've logged in.
+	color := server.NearestOwnedColor(1, message.RegisterRequest.Color)
+
+	genericFailMessage := packets.NewDenyResponse("Failed to register user (internal server error) - please try again later")
+	takenMessage := packets.NewDenyResponse("User already exists")
+
 	if _, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username)); err == nil {
-		c.logger.Printf("User already exists: %v", err)
-		c.client.SocketSend(packets.NewDenyResponse("User already exists"))
+		c.logger.Printf("User %s already exists", username)
+		c.client.SocketSend(takenMessage)
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		c.logger.Errorf("Error checking if user %s exists: %v", username, err)
+		c.client.SocketSend(genericFailMessage)
 		return
 	}
 
-	genericFailMessage := packets.NewDenyResponse("Failed to register user (internal server error) - please try again later")
+	// The username doubles as the initial display name, so it can't clash with another player's display name
+	count, err := c.queries.CountPlayersWithName(c.dbCtx, db.CountPlayersWithNameParams{Name: username})
+	if err != nil {
+		c.logger.Errorf("Error checking if display name %s is taken: %v", username, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+	if count > 0 {
+		c.logger.Printf("Display name %s is taken", username)
+		c.client.SocketSend(takenMessage)
+		return
+	}
 
 	// Add new user
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(message.RegisterRequest.Password), bcrypt.DefaultCost)
 	if err != nil {
-		c.logger.Printf("Failed to hash password: %v", err)
+		c.logger.Errorf("Failed to hash password: %v", err)
 		c.client.SocketSend(genericFailMessage)
 		return
 	}
 
+	// The checks above can race with someone else registering the same name, in which case the database's unique
+	// constraints have the final say
 	user, err := c.queries.CreateUser(c.dbCtx, db.CreateUserParams{
 		Username:     strings.ToLower(username),
 		PasswordHash: string(passwordHash),
 	})
 
-	if err != nil {
-		c.logger.Printf("Failed to create user: %v", err)
+	if server.IsUniqueConstraintError(err) {
+		c.logger.Printf("User %s was registered by someone else first", username)
+		c.client.SocketSend(takenMessage)
+		return
+	} else if err != nil {
+		c.logger.Errorf("Failed to create user: %v", err)
 		c.client.SocketSend(genericFailMessage)
 		return
 	}
@@ -141,11 +219,21 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 	_, err = c.queries.CreatePlayer(c.dbCtx, db.CreatePlayerParams{
 		UserID: user.ID,
 		Name:   username,
-		Color:  int64(message.RegisterRequest.Color),
+		Color:  int64(int32(color.Color)),
 	})
 
 	if err != nil {
-		c.logger.Printf("Failed to create player for user %s: %v", username, err)
+		// Don't leave behind a user without a player, or nobody could register the username again
+		if deleteErr := c.queries.DeleteUser(c.dbCtx, user.ID); deleteErr != nil {
+			c.logger.Errorf("Failed to remove user %s left without a player: %v", username, deleteErr)
+		}
+
+		if server.IsUniqueConstraintError(err) {
+			c.logger.Printf("Display name %s was taken by someone else first", username)
+			c.client.SocketSend(takenMessage)
+			return
+		}
+		c.logger.Errorf("Failed to create player for user %s: %v", username, err)
 		c.client.SocketSend(genericFailMessage)
 		return
 	}
@@ -154,8 +242,473 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 	c.client.SocketSend(packets.NewOkResponse())
 }
 
+func (c *Connected) handleChat(senderId uint64, message *packets.Packet_Chat) {
+	if senderId == c.client.Id() {
+		denyLobbyChat(c.client)
+		return
+	}
+	forwardChat(c.client, senderId, message, false)
+}
+
 func (c *Connected) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
-	c.client.SetState(&BrowsingHiscores{})
+	board, err := selectHiscoreBoard(c.client.DbTx(), message.HiscoreBoardRequest)
+	if err != nil {
+		c.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+
+	offset, limit := hiscorePage(message.HiscoreBoardRequest)
+	c.client.SetState(&BrowsingHiscores{board: board, offset: offset, limit: limit})
+}
+
+func (c *Connected) handleEditProfileRequest(senderId uint64, message *packets.Packet_EditProfileRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Warnf("Received edit profile request from another client (Id %d)", senderId)
+		return
+	}
+
+	request := message.EditProfileRequest
+
+	user, err := c.authenticate(request.Username, request.Password)
+	if err != nil {
+		c.logger.Warnf("Failed to authenticate user %s: %v", request.Username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Incorrect username or password"))
+		return
+	}
+
+	genericFailMessage := packets.NewDenyResponse("Failed to edit profile (internal server error) - please try again later")
+
+	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
+	if err != nil {
+		c.logger.Errorf("Error getting player for user %s: %v", user.Username, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	// An empty name or a zero color (which is never opaque, so never valid) leaves that part of the profile as it is
+	newName := player.Name
+	if request.Name != "" && request.Name != player.Name {
+		if err := c.validateNameChange(player, request.Name); err != nil {
+			reason := fmt.Sprintf("Can't change name: %v", err)
+			c.logger.Println(reason)
+			c.client.SocketSend(packets.NewDenyResponse(reason))
+			return
+		}
+		newName = request.Name
+	}
+
+	newColor := player.Color
+	if request.Color != 0 && int64(request.Color) != player.Color {
+		progress, err := server.GetPlayerProgress(c.client.DbTx(), player.ID)
+		if err != nil {
+			c.logger.Errorf("Error getting progress of player %s: %v", player.Name, err)
+			c.client.SocketSend(genericFailMessage)
+			return
+		}
+
+		if err := validateOwnedColor(request.Color, server.LevelForXp(progress.Xp)); err != nil {
+			reason := fmt.Sprintf("Invalid color: %v", err)
+			c.logger.Println(reason)
+			c.client.SocketSend(packets.NewDenyResponse(reason))
+			return
+		}
+		newColor = int64(request.Color)
+	}
+
+	// The old name is recorded along with the change, so we always know who this player used to be
+	err = c.client.DbTx().InTransaction(func(queries *db.Queries) error {
+		err := queries.UpdatePlayerProfile(c.dbCtx, db.UpdatePlayerProfileParams{
+			ID:    player.ID,
+			Name:  newName,
+			Color: newColor,
+		})
+		if err != nil || newName == player.Name {
+			return err
+		}
+		return queries.CreatePlayerNameHistory(c.dbCtx, db.CreatePlayerNameHistoryParams{
+			PlayerID:  player.ID,
+			Name:      player.Name,
+			ChangedAt: time.Now().Unix(),
+		})
+	})
+	if server.IsUniqueConstraintError(err) {
+		// Someone else took the name between it being checked and saved
+		c.logger.Printf("Can't change name of player %s to %s: name already taken", player.Name, newName)
+		c.client.SocketSend(packets.NewDenyResponse("Can't change name: name already taken"))
+		return
+	} else if err != nil {
+		c.logger.Errorf("Failed to update profile of player %s: %v", player.Name, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	c.logger.Printf("Player %s updated their profile (name: %s, color: %d)", player.Name, newName, newColor)
+	c.client.SocketSend(packets.NewOkResponse())
+}
+
+func (c *Connected) handleDeleteAccountRequest(senderId uint64, message *packets.Packet_DeleteAccountRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Warnf("Received delete account request from another client (Id %d)", senderId)
+		return
+	}
+
+	user, err := c.authenticate(message.DeleteAccountRequest.Username, message.DeleteAccountRequest.Password)
+	if err != nil {
+		c.logger.Warnf("Failed to authenticate user %s: %v", message.DeleteAccountRequest.Username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Incorrect username or password"))
+		return
+	}
+
+	if deletion, err := c.queries.GetAccountDeletion(c.dbCtx, user.ID); err == nil {
+		deleteAfter := time.Unix(deletion.DeleteAfter, 0).UTC().Format(time.RFC1123)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Account is already scheduled for deletion on %s", deleteAfter)))
+		return
+	}
+
+	now := time.Now()
+	err = c.queries.CreateAccountDeletion(c.dbCtx, db.CreateAccountDeletionParams{
+		UserID:      user.ID,
+		RequestedAt: now.Unix(),
+		DeleteAfter: now.Add(accountDeletionGracePeriod).Unix(),
+	})
+	if err != nil {
+		c.logger.Errorf("Failed to schedule deletion of user %s: %v", user.Username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Failed to delete account (internal server error) - please try again later"))
+		return
+	}
+
+	c.logger.Printf("User %s scheduled their account for deletion", user.Username)
+	c.client.SocketSend(packets.NewOkResponse())
+}
+
+func (c *Connected) handleExportDataRequest(senderId uint64, message *packets.Packet_ExportDataRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Warnf("Received export data request from another client (Id %d)", senderId)
+		return
+	}
+
+	user, err := c.authenticate(message.ExportDataRequest.Username, message.ExportDataRequest.Password)
+	if err != nil {
+		c.logger.Warnf("Failed to authenticate user %s: %v", message.ExportDataRequest.Username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Incorrect username or password"))
+		return
+	}
+
+	export, err := c.exportUserData(user)
+	if err != nil {
+		c.logger.Errorf("Failed to export data of user %s: %v", user.Username, err)
+		c.client.SocketSend(packets.NewDenyResponse("Failed to export data (internal server error) - please try again later"))
+		return
+	}
+
+	c.logger.Printf("Exported data of user %s", user.Username)
+	c.client.SocketSend(packets.NewDataExport(export))
+}
+
+// Gathers everything we store about the user into a JSON document (except their password hash)
+func (c *Connected) exportUserData(user db.User) (string, error) {
+	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting player: %w", err)
+	}
+
+	nameHistory, err := c.queries.GetPlayerNameHistory(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting name history: %w", err)
+	}
+
+	type exportedName struct {
+		Name      string    `json:"name"`
+		ChangedAt time.Time `json:"changed_at"`
+	}
+
+	type exportedLogin struct {
+		IpAddress  string    `json:"ip_address"`
+		LoggedInAt time.Time `json:"logged_in_at"`
+	}
+
+	type exportedChatMessage struct {
+		Channel string    `json:"channel"`
+		Target  string    `json:"target,omitempty"`
+		Msg     string    `json:"msg"`
+		SentAt  time.Time `json:"sent_at"`
+	}
+
+	type exportedScore struct {
+		Score     int64     `json:"score"`
+		StartedAt time.Time `json:"started_at"`
+		EndedAt   time.Time `json:"ended_at"`
+	}
+
+	type exportedSession struct {
+		StartedAt        time.Time `json:"started_at"`
+		EndedAt          time.Time `json:"ended_at"`
+		SporesEaten      int64     `json:"spores_eaten"`
+		PlayersEaten     int64     `json:"players_eaten"`
+		TimesEaten       int64     `json:"times_eaten"`
+		SecondsAlive     int64     `json:"seconds_alive"`
+		MaxMass          int64     `json:"max_mass"`
+		DistanceTraveled float64   `json:"distance_traveled"`
+	}
+
+	type exportedAchievement struct {
+		Id         string    `json:"id"`
+		UnlockedAt time.Time `json:"unlocked_at"`
+	}
+
+	type exportedDeletion struct {
+		RequestedAt time.Time `json:"requested_at"`
+		DeleteAfter time.Time `json:"delete_after"`
+	}
+
+	role, err := server.GetUserRole(c.client.DbTx(), user.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting role: %w", err)
+	}
+
+	export := struct {
+		ExportedAt      time.Time             `json:"exported_at"`
+		UserId          int64                 `json:"user_id"`
+		Username        string                `json:"username"`
+		Role            server.Role           `json:"role"`
+		PlayerId        int64                 `json:"player_id"`
+		Name            string                `json:"name"`
+		Color           int64                 `json:"color"`
+		BestScore       int64                 `json:"best_score"`
+		Xp              int64                 `json:"xp"`
+		Level           int64                 `json:"level"`
+		Badge           string                `json:"badge"`
+		PreviousNames   []exportedName        `json:"previous_names"`
+		Logins          []exportedLogin       `json:"logins"`
+		ChatMessages    []exportedChatMessage `json:"chat_messages"`
+		BlockedPlayers  []string              `json:"blocked_players"`
+		Friends         []string              `json:"friends"`
+		FriendRequests  []string              `json:"friend_requests"`
+		ScoreHistory    []exportedScore       `json:"score_history"`
+		Sessions        []exportedSession     `json:"sessions"`
+		Achievements    []exportedAchievement `json:"achievements"`
+		PendingDeletion *exportedDeletion     `json:"pending_deletion"`
+	}{
+		ExportedAt:    time.Now().UTC(),
+		UserId:        user.ID,
+		Username:      user.Username,
+		Role:          role,
+		PlayerId:      player.ID,
+		Name:          player.Name,
+		Color:         player.Color,
+		BestScore:     player.BestScore,
+		PreviousNames: make([]exportedName, 0, len(nameHistory)),
+	}
+
+	for _, entry := range nameHistory {
+		export.PreviousNames = append(export.PreviousNames, exportedName{
+			Name:      entry.Name,
+			ChangedAt: time.Unix(entry.ChangedAt, 0).UTC(),
+		})
+	}
+
+	logins, err := c.queries.GetLoginHistory(c.dbCtx, user.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting login history: %w", err)
+	}
+
+	export.Logins = make([]exportedLogin, 0, len(logins))
+	for _, login := range logins {
+		export.Logins = append(export.Logins, exportedLogin{
+			IpAddress:  login.IpAddress,
+			LoggedInAt: time.Unix(login.LoggedInAt, 0).UTC(),
+		})
+	}
+
+	chatMessages, err := c.queries.GetChatMessagesByPlayerId(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting chat messages: %w", err)
+	}
+
+	export.ChatMessages = make([]exportedChatMessage, 0, len(chatMessages))
+	for _, message := range chatMessages {
+		export.ChatMessages = append(export.ChatMessages, exportedChatMessage{
+			Channel: packets.ChatChannel(message.Channel).String(),
+			Target:  message.Target,
+			Msg:     message.Msg,
+			SentAt:  time.Unix(message.SentAt, 0).UTC(),
+		})
+	}
+
+	blocks, err := c.queries.GetPlayerBlocks(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting blocked players: %w", err)
+	}
+
+	export.BlockedPlayers = make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		export.BlockedPlayers = append(export.BlockedPlayers, block.Name)
+	}
+
+	friends, err := c.queries.GetFriends(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting friends: %w", err)
+	}
+
+	export.Friends = make([]string, 0, len(friends))
+	for _, friend := range friends {
+		export.Friends = append(export.Friends, friend.Name)
+	}
+
+	friendRequests, err := c.queries.GetOutgoingFriendRequests(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting friend requests: %w", err)
+	}
+
+	export.FriendRequests = make([]string, 0, len(friendRequests))
+	for _, request := range friendRequests {
+		export.FriendRequests = append(export.FriendRequests, request.Name)
+	}
+
+	scores, err := c.queries.GetScoreHistoryByPlayerId(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting score history: %w", err)
+	}
+
+	export.ScoreHistory = make([]exportedScore, 0, len(scores))
+	for _, score := range scores {
+		export.ScoreHistory = append(export.ScoreHistory, exportedScore{
+			Score:     score.Score,
+			StartedAt: time.Unix(score.StartedAt, 0).UTC(),
+			EndedAt:   time.Unix(score.EndedAt, 0).UTC(),
+		})
+	}
+
+	sessions, err := c.queries.GetSessionStatsByPlayerId(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting session stats: %w", err)
+	}
+
+	export.Sessions = make([]exportedSession, 0, len(sessions))
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, exportedSession{
+			StartedAt:        time.Unix(session.StartedAt, 0).UTC(),
+			EndedAt:          time.Unix(session.EndedAt, 0).UTC(),
+			SporesEaten:      session.SporesEaten,
+			PlayersEaten:     session.PlayersEaten,
+			TimesEaten:       session.TimesEaten,
+			SecondsAlive:     session.SecondsAlive,
+			MaxMass:          session.MaxMass,
+			DistanceTraveled: session.DistanceTraveled,
+		})
+	}
+
+	achievements, err := c.queries.GetPlayerAchievements(c.dbCtx, player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting achievements: %w", err)
+	}
+
+	progress, err := server.GetPlayerProgress(c.client.DbTx(), player.ID)
+	if err != nil {
+		return "", fmt.Errorf("error getting progress: %w", err)
+	}
+	export.Xp = progress.Xp
+	export.Level = server.LevelForXp(progress.Xp)
+	export.Badge = progress.Badge
+
+	export.Achievements = make([]exportedAchievement, 0, len(achievements))
+	for _, achievement := range achievements {
+		export.Achievements = append(export.Achievements, exportedAchievement{
+			Id:         achievement.AchievementID,
+			UnlockedAt: time.Unix(achievement.UnlockedAt, 0).UTC(),
+		})
+	}
+
+	if deletion, err := c.queries.GetAccountDeletion(c.dbCtx, user.ID); err == nil {
+		export.PendingDeletion = &exportedDeletion{
+			RequestedAt: time.Unix(deletion.RequestedAt, 0).UTC(),
+			DeleteAfter: time.Unix(deletion.DeleteAfter, 0).UTC(),
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("error getting pending deletion: %w", err)
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// Checks neither the client's IP address nor the user (if non-zero) is banned, and lets the client know if they are
+func (c *Connected) checkNotBanned(userId int64) bool {
+	genericFailMessage := packets.NewDenyResponse("Failed to check ban status (internal server error) - please try again later")
+
+	ban, err := server.GetActiveIpBan(c.client.DbTx(), c.client.IpAddress())
+	if err != nil {
+		c.logger.Errorf("Error checking ban status of IP address %s: %v", c.client.IpAddress(), err)
+		c.client.SocketSend(genericFailMessage)
+		return false
+	}
+
+	if ban == nil && userId != 0 {
+		ban, err = server.GetActiveUserSanction(c.client.DbTx(), userId, server.SanctionBan)
+		if err != nil {
+			c.logger.Errorf("Error checking ban status of user %d: %v", userId, err)
+			c.client.SocketSend(genericFailMessage)
+			return false
+		}
+	}
+
+	if ban != nil {
+		c.logger.Printf("Turning away banned client (user %d, IP address %s)", userId, c.client.IpAddress())
+		c.client.SocketSend(packets.NewDenyResponse(ban.Describe(server.SanctionBan.PastTense())))
+		return false
+	}
+
+	return true
+}
+
+// Looks up the user with the given username and checks the password against their stored hash
+func (c *Connected) authenticate(username, password string) (db.User, error) {
+	user, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username))
+	if err != nil {
+		return db.User{}, fmt.Errorf("error getting user by username: %w", err)
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
+	if err != nil {
+		return db.User{}, errors.New("incorrect password")
+	}
+
+	return user, nil
+}
+
+func (c *Connected) validateNameChange(player db.Player, newName string) error {
+	if err := validateUsername(newName); err != nil {
+		return err
+	}
+
+	count, err := c.queries.CountPlayersWithName(c.dbCtx, db.CountPlayersWithNameParams{
+		Name: newName,
+		ID:   player.ID,
+	})
+	if err != nil {
+		c.logger.Errorf("Error checking if name %s is taken: %v", newName, err)
+		return errors.New("internal server error")
+	}
+	if count > 0 {
+		return errors.New("name already taken")
+	}
+
+	lastChangedAt, err := c.queries.GetLastPlayerNameChange(c.dbCtx, player.ID)
+	if err == nil {
+		nextAllowed := time.Unix(lastChangedAt, 0).Add(nameChangeCooldown)
+		if time.Now().Before(nextAllowed) {
+			return fmt.Errorf("please wait %v before changing your name again", time.Until(nextAllowed).Round(time.Minute))
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		c.logger.Errorf("Error getting last name change of player %s: %v", player.Name, err)
+		return errors.New("internal server error")
+	}
+
+	return nil
 }
 
 func validateUsername(username string) error {
@@ -168,5 +721,16 @@ func validateUsername(username string) error {
 	if username != strings.TrimSpace(username) {
 		return errors.New("leading or trailing whitespace")
 	}
+	if strings.EqualFold(username, server.DeletedPlayerName) {
+		return errors.New("reserved")
+	}
+	return nil
+}
+
+// Colors are RGBA32, and we don't want anyone turning themselves invisible
+func validateColor(color int32) error {
+	if color&0xFF != 0xFF {
+		return errors.New("must be fully opaque")
+	}
 	return nil
 }
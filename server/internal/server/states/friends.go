@@ -0,0 +1,359 @@
+package states
+
+import (
+	"errors"
+	"fmt"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// The most friends a player can have at once
+const maxFriends = 100
+
+// Sends our client their friends and who's online, along with the friend requests waiting on them or on others
+func (g *InGame) sendFriendList() {
+	queries, ctx := g.client.DbTx().Queries, g.client.DbTx().Ctx
+
+	friends, err := queries.GetFriends(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting friends: %v", err)
+		return
+	}
+	incoming, err := queries.GetIncomingFriendRequests(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting incoming friend requests: %v", err)
+		return
+	}
+	outgoing, err := queries.GetOutgoingFriendRequests(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting outgoing friend requests: %v", err)
+		return
+	}
+
+	presences := make([]*packets.FriendPresenceMessage, 0, len(friends))
+	for _, friend := range friends {
+		presences = append(presences, g.friendPresence(friend.ID, friend.Name))
+	}
+
+	incomingNames := make([]string, 0, len(incoming))
+	for _, request := range incoming {
+		incomingNames = append(incomingNames, request.Name)
+	}
+
+	outgoingNames := make([]string, 0, len(outgoing))
+	for _, request := range outgoing {
+		outgoingNames = append(outgoingNames, request.Name)
+	}
+
+	g.client.SocketSend(packets.NewFriendList(presences, incomingNames, outgoingNames))
+}
+
+// Whether the player with the given database ID is in the game right now, and which arena they're in if so
+func (g *InGame) friendPresence(dbId int64, name string) *packets.FriendPresenceMessage {
+	presence := &packets.FriendPresenceMessage{Name: name}
+	if _, friend, found := g.findPlayerByDbId(dbId); found {
+		presence.Name = friend.Name
+		presence.Online = true
+		presence.Arena = friend.Arena
+	}
+	return presence
+}
+
+// Lets our player's friends who are in the game know whether our player is online, and which arena they're in
+func (g *InGame) announcePresence(online bool) {
+	arena := ""
+	if online {
+		arena = g.player.Arena
+	}
+
+	friends, err := g.client.DbTx().Queries.GetFriends(g.client.DbTx().Ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting friends to tell about our presence: %v", err)
+		return
+	}
+
+	for _, friend := range friends {
+		if friendId, _, found := g.findPlayerByDbId(friend.ID); found {
+			g.client.PassToPeer(packets.NewFriendPresence(g.player.Name, online, arena), friendId)
+		}
+	}
+}
+
+// Sends the named player a friend request, or accepts theirs if they've already sent one to our player.
+// Returns what happened, and errors meant to be shown to the player as they are.
+func (g *InGame) addFriend(name string) (string, error) {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to add as a friend: %v", name, err)
+		return "", errors.New("No player found with that name")
+	}
+
+	if target.ID == g.player.DbId {
+		return "", errors.New("You can't add yourself as a friend")
+	}
+	if err := g.checkCanBefriend(target); err != nil {
+		return "", err
+	}
+
+	// Two players asking to be friends with each other is as good as one accepting the other's request
+	accepted, err := g.client.DbTx().Queries.DeleteFriendRequest(g.client.DbTx().Ctx, db.DeleteFriendRequestParams{
+		RequesterPlayerID: target.ID,
+		RecipientPlayerID: g.player.DbId,
+	})
+	if err != nil {
+		g.logger.Errorf("Error checking for a friend request from %s: %v", target.Name, err)
+		return "", errors.New("Failed to add friend (internal server error) - please try again later")
+	}
+	if accepted > 0 {
+		return g.makeFriends(target)
+	}
+
+	sent, err := g.client.DbTx().Queries.CreateFriendRequest(g.client.DbTx().Ctx, db.CreateFriendRequestParams{
+		RequesterPlayerID: g.player.DbId,
+		RecipientPlayerID: target.ID,
+		CreatedAt:         time.Now().Unix(),
+	})
+	if err != nil {
+		g.logger.Errorf("Error sending friend request to %s: %v", target.Name, err)
+		return "", errors.New("Failed to add friend (internal server error) - please try again later")
+	}
+	if sent == 0 {
+		return "", fmt.Errorf("You've already sent %s a friend request", target.Name)
+	}
+
+	if targetId, _, found := g.findPlayerByDbId(target.ID); found {
+		g.client.PassToPeer(packets.NewAddFriendRequest(g.player.Name), targetId)
+	}
+	return fmt.Sprintf("Sent a friend request to %s", target.Name), nil
+}
+
+// Accepts the friend request the named player sent our player. Returns what happened, and errors meant to be shown
+// to the player as they are.
+func (g *InGame) acceptFriend(name string) (string, error) {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to accept as a friend: %v", name, err)
+		return "", errors.New("No player found with that name")
+	}
+
+	if err := g.checkCanBefriend(target); err != nil {
+		return "", err
+	}
+
+	accepted, err := g.client.DbTx().Queries.DeleteFriendRequest(g.client.DbTx().Ctx, db.DeleteFriendRequestParams{
+		RequesterPlayerID: target.ID,
+		RecipientPlayerID: g.player.DbId,
+	})
+	if err != nil {
+		g.logger.Errorf("Error accepting friend request from %s: %v", target.Name, err)
+		return "", errors.New("Failed to accept friend request (internal server error) - please try again later")
+	}
+	if accepted == 0 {
+		return "", fmt.Errorf("%s hasn't sent you a friend request", target.Name)
+	}
+
+	return g.makeFriends(target)
+}
+
+// Checks our player can become friends with the target. Errors returned are meant to be shown to the player.
+func (g *InGame) checkCanBefriend(target db.Player) error {
+	queries, ctx := g.client.DbTx().Queries, g.client.DbTx().Ctx
+
+	isFriend, err := queries.IsFriend(ctx, db.IsFriendParams{PlayerID: g.player.DbId, FriendPlayerID: target.ID})
+	if err != nil {
+		g.logger.Errorf("Error checking if %s is already a friend: %v", target.Name, err)
+		return errors.New("Failed to add friend (internal server error) - please try again later")
+	}
+	if isFriend > 0 {
+		return fmt.Errorf("%s is already your friend", target.Name)
+	}
+
+	if g.blocked.contains(target.ID) {
+		return fmt.Errorf("You have blocked %s", target.Name)
+	}
+	if blocked, err := hasBlocked(g.client.DbTx(), target.ID, g.player.DbId); err != nil {
+		g.logger.Errorf("Error checking if %s has blocked our player: %v", target.Name, err)
+		return errors.New("Failed to add friend (internal server error) - please try again later")
+	} else if blocked {
+		return fmt.Errorf("%s isn't accepting friend requests from you", target.Name)
+	}
+
+	friendCount, err := queries.CountFriends(ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error counting friends: %v", err)
+		return errors.New("Failed to add friend (internal server error) - please try again later")
+	}
+	if friendCount >= maxFriends {
+		return fmt.Errorf("You can't have more than %d friends", maxFriends)
+	}
+
+	return nil
+}
+
+// Records our player and the target as friends of each other, and lets them both know who's online
+func (g *InGame) makeFriends(target db.Player) (string, error) {
+	queries, ctx := g.client.DbTx().Queries, g.client.DbTx().Ctx
+	now := time.Now().Unix()
+
+	for _, pair := range [][2]int64{{g.player.DbId, target.ID}, {target.ID, g.player.DbId}} {
+		err := queries.CreateFriend(ctx, db.CreateFriendParams{
+			PlayerID:       pair[0],
+			FriendPlayerID: pair[1],
+			CreatedAt:      now,
+		})
+		if err != nil {
+			g.logger.Errorf("Error adding %s as a friend: %v", target.Name, err)
+			return "", errors.New("Failed to add friend (internal server error) - please try again later")
+		}
+	}
+
+	// Any request our player sent the other way has been answered too
+	if _, err := queries.DeleteFriendRequest(ctx, db.DeleteFriendRequestParams{
+		RequesterPlayerID: g.player.DbId,
+		RecipientPlayerID: target.ID,
+	}); err != nil {
+		g.logger.Errorf("Error clearing our friend request to %s: %v", target.Name, err)
+	}
+
+	presence := g.friendPresence(target.ID, target.Name)
+	g.client.SocketSend(&packets.Packet_FriendPresence{FriendPresence: presence})
+	if targetId, _, found := g.findPlayerByDbId(target.ID); found {
+		g.client.PassToPeer(packets.NewAcceptFriendRequest(g.player.Name), targetId)
+		g.client.PassToPeer(packets.NewFriendPresence(g.player.Name, true, g.player.Arena), targetId)
+	}
+
+	return fmt.Sprintf("You and %s are now friends", target.Name), nil
+}
+
+// Removes the named player from our player's friends, or declines or cancels a friend request between them.
+// Returns what happened, and errors meant to be shown to the player as they are.
+func (g *InGame) removeFriend(name string) (string, error) {
+	queries, ctx := g.client.DbTx().Queries, g.client.DbTx().Ctx
+
+	target, err := queries.GetPlayerByExactName(ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to remove as a friend: %v", name, err)
+		return "", errors.New("No player found with that name")
+	}
+
+	genericFailMessage := errors.New("Failed to remove friend (internal server error) - please try again later")
+
+	removed, err := queries.DeleteFriend(ctx, db.DeleteFriendParams{
+		PlayerID:         g.player.DbId,
+		FriendPlayerID:   target.ID,
+		PlayerID_2:       target.ID,
+		FriendPlayerID_2: g.player.DbId,
+	})
+	if err != nil {
+		g.logger.Errorf("Error removing friend %s: %v", target.Name, err)
+		return "", genericFailMessage
+	}
+	outcome := fmt.Sprintf("%s is no longer your friend", target.Name)
+
+	if removed == 0 {
+		declined, err := queries.DeleteFriendRequest(ctx, db.DeleteFriendRequestParams{
+			RequesterPlayerID: target.ID,
+			RecipientPlayerID: g.player.DbId,
+		})
+		if err != nil {
+			g.logger.Errorf("Error declining friend request from %s: %v", target.Name, err)
+			return "", genericFailMessage
+		}
+		outcome = fmt.Sprintf("Declined %s's friend request", target.Name)
+
+		if declined == 0 {
+			cancelled, err := queries.DeleteFriendRequest(ctx, db.DeleteFriendRequestParams{
+				RequesterPlayerID: g.player.DbId,
+				RecipientPlayerID: target.ID,
+			})
+			if err != nil {
+				g.logger.Errorf("Error cancelling friend request to %s: %v", target.Name, err)
+				return "", genericFailMessage
+			}
+			if cancelled == 0 {
+				return "", fmt.Errorf("%s isn't your friend", target.Name)
+			}
+			outcome = fmt.Sprintf("Cancelled your friend request to %s", target.Name)
+		}
+	}
+
+	if targetId, _, found := g.findPlayerByDbId(target.ID); found {
+		g.client.PassToPeer(packets.NewRemoveFriendRequest(g.player.Name), targetId)
+	}
+	return outcome, nil
+}
+
+// Moves our player into the arena the named friend is playing in. Errors returned are meant to be shown to the player.
+func (g *InGame) joinFriend(name string) error {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting friend %s to join: %v", name, err)
+		return errors.New("No player found with that name")
+	}
+
+	isFriend, err := g.client.DbTx().Queries.IsFriend(g.client.DbTx().Ctx, db.IsFriendParams{
+		PlayerID:       g.player.DbId,
+		FriendPlayerID: target.ID,
+	})
+	if err != nil {
+		g.logger.Errorf("Error checking if %s is a friend: %v", target.Name, err)
+		return errors.New("Failed to join friend (internal server error) - please try again later")
+	}
+	if isFriend == 0 {
+		return fmt.Errorf("%s isn't your friend", target.Name)
+	}
+
+	_, friend, found := g.findPlayerByDbId(target.ID)
+	if !found {
+		return fmt.Errorf("%s isn't in the game", target.Name)
+	}
+	if friend.Arena == g.player.Arena {
+		return fmt.Errorf("You're already in the same arena as %s", friend.Name)
+	}
+
+	g.logger.Printf("Moving from arena %s to %s to join friend %s", g.player.Arena, friend.Arena, friend.Name)
+	g.player.Arena = friend.Arena
+	g.announcePresence(true)
+	g.respawn()
+	return nil
+}
+
+// Forgets any friendship or friend request between our player and the target, without telling either of them
+func (g *InGame) severFriendship(target db.Player) error {
+	queries, ctx := g.client.DbTx().Queries, g.client.DbTx().Ctx
+
+	_, err := queries.DeleteFriend(ctx, db.DeleteFriendParams{
+		PlayerID:         g.player.DbId,
+		FriendPlayerID:   target.ID,
+		PlayerID_2:       target.ID,
+		FriendPlayerID_2: g.player.DbId,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range [][2]int64{{g.player.DbId, target.ID}, {target.ID, g.player.DbId}} {
+		_, err := queries.DeleteFriendRequest(ctx, db.DeleteFriendRequestParams{
+			RequesterPlayerID: pair[0],
+			RecipientPlayerID: pair[1],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Finds the ID of the client whose player has the given database ID, if they're in the game
+func (g *InGame) findPlayerByDbId(dbId int64) (uint64, *objects.Player, bool) {
+	var foundId uint64
+	var foundPlayer *objects.Player
+	g.client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if foundPlayer == nil && player.DbId == dbId {
+			foundId, foundPlayer = playerId, player
+		}
+	})
+	return foundId, foundPlayer, foundPlayer != nil
+}
@@ -2,22 +2,73 @@ package states
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"math"
 	"math/rand/v2"
 	"server/internal/server"
 	"server/internal/server/db"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"strings"
 	"time"
+	"unicode/utf8"
+)
+
+// Builds the in-game representation of a player from their database record
+func newPlayerObject(player db.Player) *objects.Player {
+	return &objects.Player{
+		Name:      player.Name,
+		DbId:      player.ID,
+		BestScore: player.BestScore,
+		Color:     int32(player.Color),
+		Arena:     server.MainArena,
+	}
+}
+
+const (
+	// The most chat messages a moderator can get back from a single search
+	maxChatSearchResults = 200
+
+	// The longest explanation a player can give when reporting someone, in characters
+	maxReportDetailsLength = 1000
+
+	// The most players someone can have blocked at once
+	maxBlockedPlayers = 100
+
+	// How often a rising best score is written to the database during a life. It's always written when the player dies
+	// or leaves, so this only bounds what's lost if the server goes down.
+	bestScoreSaveInterval = 10 * time.Second
 )
 
 type InGame struct {
 	client                 server.ClientInterfacer
 	player                 *objects.Player
-	logger                 *log.Logger
+	logger                 *logging.Logger
 	cancelPlayerUpdateLoop context.CancelFunc
+
+	// Loaded from the database when the player joins the game, then kept across respawns
+	blocked      *blockList
+	achievements *server.AchievementTracker
+
+	// Whether the player is coming back after being consumed, rather than joining the game
+	respawned bool
+
+	// Whether the player is leaving this state to respawn, rather than leaving the game
+	respawning bool
+
+	// When this life started, and the highest score reached during it, for the score history
+	spawnedAt time.Time
+	peakScore int64
+
+	// How far the player has moved during this life, for their stats
+	distanceTraveled float64
+
+	// The best score last written to the database, and when, so a rising best score isn't written on every spore
+	savedBestScore   int64
+	bestScoreSavedAt time.Time
 }
 
 func (g *InGame) Name() string {
@@ -26,14 +77,36 @@ func (g *InGame) Name() string {
 
 func (g *InGame) SetClient(client server.ClientInterfacer) {
 	g.client = client
-	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
-	g.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	g.logger = logger.With(
+		"client_id", client.Id(),
+		"state", g.Name(),
+		"account_id", client.AccountId(),
+		"player", g.player.Name,
+		"arena", g.player.Arena,
+	)
 }
 
 func (g *InGame) OnEnter() {
-	log.Printf("Adding player %s to the shared collection", g.player.Name)
+	if g.blocked == nil {
+		g.loadBlockList()
+	}
+	if g.achievements == nil {
+		g.loadAchievements()
+	}
+	if !g.respawned {
+		g.loadProgress()
+	}
+
+	g.logger.Printf("Adding player %s to the shared collection", g.player.Name)
 	go g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
 
+	g.spawnedAt = time.Now()
+	g.savedBestScore = g.player.BestScore
+	if !g.respawned {
+		g.recordEvent(server.StatsEvent{Kind: server.StatsSessionStarted, PlayerDbId: g.player.DbId, At: g.spawnedAt})
+	}
+	g.recordEvent(server.StatsEvent{Kind: server.StatsLifeStarted, PlayerDbId: g.player.DbId, At: g.spawnedAt})
+
 	// Set the initial properties of the player
 	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
 	g.player.Speed = 150.0
@@ -44,6 +117,13 @@ func (g *InGame) OnEnter() {
 
 	// Send the spores to the client in the background
 	go g.sendInitialSpores(20, 50*time.Millisecond)
+
+	// Catch the player up on what's been said recently, unless they've only been away for a respawn
+	if !g.respawned {
+		go g.replayChat(chatReplayLength)
+		go g.sendFriendList()
+		go g.announcePresence(true)
+	}
 }
 
 func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
@@ -62,6 +142,42 @@ func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
 		g.handleSpore(senderId, message)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, message)
+	case *packets.Packet_KickPlayerRequest:
+		g.handleKickPlayerRequest(senderId, message)
+	case *packets.Packet_MutePlayerRequest:
+		g.handleMutePlayerRequest(senderId, message)
+	case *packets.Packet_AnnounceRequest:
+		g.handleAnnounceRequest(senderId, message)
+	case *packets.Packet_BanPlayerRequest:
+		g.handleBanPlayerRequest(senderId, message)
+	case *packets.Packet_UnbanPlayerRequest:
+		g.handleUnbanPlayerRequest(senderId, message)
+	case *packets.Packet_UnmutePlayerRequest:
+		g.handleUnmutePlayerRequest(senderId, message)
+	case *packets.Packet_SearchChatRequest:
+		g.handleSearchChatRequest(senderId, message)
+	case *packets.Packet_ReportPlayerRequest:
+		g.handleReportPlayerRequest(senderId, message)
+	case *packets.Packet_BlockPlayerRequest:
+		g.handleBlockPlayerRequest(senderId, message)
+	case *packets.Packet_UnblockPlayerRequest:
+		g.handleUnblockPlayerRequest(senderId, message)
+	case *packets.Packet_AddFriendRequest:
+		g.handleAddFriendRequest(senderId, message)
+	case *packets.Packet_AcceptFriendRequest:
+		g.handleAcceptFriendRequest(senderId, message)
+	case *packets.Packet_RemoveFriendRequest:
+		g.handleRemoveFriendRequest(senderId, message)
+	case *packets.Packet_FriendPresence:
+		g.handleFriendPresence(senderId, message)
+	case *packets.Packet_JoinFriendRequest:
+		g.handleJoinFriendRequest(senderId, message)
+	case *packets.Packet_StatsRequest:
+		g.handleStatsRequest(senderId, message)
+	case *packets.Packet_AchievementUnlocked:
+		g.handleAchievementUnlocked(senderId, message)
+	case *packets.Packet_EquipCosmeticRequest:
+		g.handleEquipCosmeticRequest(senderId, message)
 	}
 }
 
@@ -71,11 +187,25 @@ func (g *InGame) OnExit() {
 	}
 	g.client.SharedGameObjects().Players.Remove(g.client.Id())
 	g.syncPlayerBestScore()
+	if g.player.BestScore > g.savedBestScore {
+		g.savedBestScore = g.player.BestScore
+		g.saveBestScore(g.player.DbId, g.player.BestScore)
+	}
+	g.recordScore()
+
+	g.recordEvent(server.StatsEvent{Kind: server.StatsLifeEnded, PlayerDbId: g.player.DbId, Amount: g.distanceTraveled})
+	if !g.respawning {
+		g.recordEvent(server.StatsEvent{Kind: server.StatsSessionEnded, PlayerDbId: g.player.DbId})
+	}
+
+	if !g.respawning {
+		g.announcePresence(false)
+	}
 }
 
 func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
 	if senderId == g.client.Id() {
-		g.logger.Println("Received player message from our own client, ignoring")
+		g.logger.Debugf("Received player message from our own client, ignoring")
 		return
 	}
 
@@ -84,7 +214,7 @@ func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
 
 func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_PlayerDirection) {
 	if senderId != g.client.Id() {
-		g.logger.Println("Received player direction message from a different client, ignoring")
+		g.logger.Debugf("Received player direction message from a different client, ignoring")
 		return
 	}
 
@@ -99,11 +229,219 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 }
 
 func (g *InGame) handleChat(senderId uint64, message *packets.Packet_Chat) {
-	if senderId == g.client.Id() {
+	if senderId != g.client.Id() {
+		if message.Chat.Channel != packets.ChatChannel_CHAT_CHANNEL_SYSTEM && g.isBlockedSender(senderId, message.Chat.SenderName) {
+			return
+		}
+		if message.Chat.Channel == packets.ChatChannel_CHAT_CHANNEL_ARENA && !g.inSameArena(senderId) {
+			return
+		}
+		forwardChat(g.client, senderId, message, true)
+		return
+	}
+
+	if strings.HasPrefix(message.Chat.Msg, "/") {
+		g.runChatCommand(message.Chat.Msg)
+		return
+	}
+
+	if err := g.sendChat(message.Chat); err != nil {
+		g.client.SocketSend(packets.NewDenyResponse(err.Error()))
+	}
+}
+
+// Checks our player is allowed to send the chat message, then sends it to whoever is on the channel.
+// Errors returned are meant to be shown to the player as they are.
+func (g *InGame) sendChat(chat *packets.ChatMessage) error {
+	mute, err := server.GetActiveUserSanction(g.client.DbTx(), g.client.AccountId(), server.SanctionMute)
+	if err != nil {
+		g.logger.Errorf("Error checking if player is muted, letting the message through: %v", err)
+	} else if mute != nil {
+		return errors.New(mute.Describe(server.SanctionMute.PastTense()))
+	}
+
+	filtered, muteFor, err := g.client.ChatFilter().Check(g.client.AccountId(), chat.Msg)
+	if err != nil {
+		if muteFor > 0 {
+			return g.muteForFlooding(muteFor)
+		}
+		return fmt.Errorf("Message not sent: %w", err)
+	}
+	chat.Msg = filtered
+
+	// Don't trust the client to tell us who they are or when they sent it
+	chat.SenderName = g.player.Name
+	chat.SentAt = time.Now().Unix()
+	message := &packets.Packet_Chat{Chat: chat}
+
+	switch chat.Channel {
+	case packets.ChatChannel_CHAT_CHANNEL_GLOBAL, packets.ChatChannel_CHAT_CHANNEL_ARENA:
 		g.client.Broadcast(message)
-	} else {
-		g.client.SocketSendAs(message, senderId)
+	case packets.ChatChannel_CHAT_CHANNEL_WHISPER:
+		targetId, target, found := g.findPlayerByName(chat.Target)
+		if !found {
+			return fmt.Errorf("No player named %s is online", chat.Target)
+		}
+		if targetId == g.client.Id() {
+			return errors.New("You can't whisper to yourself")
+		}
+		if blocked, err := hasBlocked(g.client.DbTx(), target.DbId, g.player.DbId); err != nil {
+			g.logger.Errorf("Error checking if %s has blocked our player, letting the whisper through: %v", target.Name, err)
+		} else if blocked {
+			return fmt.Errorf("%s isn't accepting whispers from you", target.Name)
+		}
+		g.client.PassToPeer(message, targetId)
+	default:
+		g.logger.Warnf("Received chat message on channel %v which players can't send to", chat.Channel)
+		return errors.New("You can't send messages to that channel")
+	}
+
+	g.recordChat(chat)
+	return nil
+}
+
+// Saves a chat message our player sent, so it can be replayed to players joining the game and searched by moderators
+func (g *InGame) recordChat(chat *packets.ChatMessage) {
+	err := g.client.DbTx().Queries.CreateChatMessage(g.client.DbTx().Ctx, db.CreateChatMessageParams{
+		SenderPlayerID: g.player.DbId,
+		SenderName:     chat.SenderName,
+		Channel:        int64(chat.Channel),
+		Arena:          g.player.Arena,
+		Target:         chat.Target,
+		Msg:            chat.Msg,
+		SentAt:         chat.SentAt,
+	})
+	if err != nil {
+		g.logger.Errorf("Error recording chat message: %v", err)
+	}
+}
+
+// Sends our client the most recent global and arena chat messages, oldest first
+func (g *InGame) replayChat(limit int64) {
+	records, err := g.client.DbTx().Queries.GetRecentPublicChatMessages(g.client.DbTx().Ctx, db.GetRecentPublicChatMessagesParams{
+		Channel:   int64(packets.ChatChannel_CHAT_CHANNEL_GLOBAL),
+		Channel_2: int64(packets.ChatChannel_CHAT_CHANNEL_ARENA),
+		Arena:     g.player.Arena,
+		Limit:     limit,
+	})
+	if err != nil {
+		g.logger.Errorf("Error getting recent chat messages to replay: %v", err)
+		return
+	}
+
+	for i := len(records) - 1; i >= 0; i-- {
+		if g.blocked.contains(records[i].SenderPlayerID) {
+			continue
+		}
+		g.client.SocketSendAs(chatFromRecord(records[i]), 0)
+	}
+}
+
+// Loads the players our player has blocked from the database, and lets our client know who they are
+func (g *InGame) loadBlockList() {
+	g.blocked = newBlockList()
+
+	blocks, err := g.client.DbTx().Queries.GetPlayerBlocks(g.client.DbTx().Ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error loading block list, starting with an empty one: %v", err)
+		return
+	}
+
+	for _, block := range blocks {
+		g.blocked.add(block.ID, block.Name)
 	}
+	g.client.SocketSend(packets.NewBlockList(g.blocked.list()))
+}
+
+// Whether the chat message from another client was sent by someone our player has blocked
+func (g *InGame) isBlockedSender(senderId uint64, senderName string) bool {
+	if sender, exists := g.client.SharedGameObjects().Players.Get(senderId); exists {
+		return g.blocked.contains(sender.DbId)
+	}
+
+	// The sender might be between respawns, so fall back to their name
+	return g.blocked.containsName(senderName)
+}
+
+// Whether the player of another client is in the same arena as ours. Players between respawns are given the benefit
+// of the doubt.
+func (g *InGame) inSameArena(otherId uint64) bool {
+	other, exists := g.client.SharedGameObjects().Players.Get(otherId)
+	return !exists || other.Arena == g.player.Arena
+}
+
+// Blocks the named player from chatting or whispering to our player, and saves it for future sessions.
+// Errors returned are meant to be shown to the player as they are.
+func (g *InGame) blockPlayer(name string) (string, error) {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to block: %v", name, err)
+		return "", errors.New("No player found with that name")
+	}
+
+	if target.ID == g.player.DbId {
+		return "", errors.New("You can't block yourself")
+	}
+	if g.blocked.contains(target.ID) {
+		return "", fmt.Errorf("You have already blocked %s", target.Name)
+	}
+	if g.blocked.len() >= maxBlockedPlayers {
+		return "", fmt.Errorf("You can't block more than %d players", maxBlockedPlayers)
+	}
+
+	err = g.client.DbTx().Queries.CreatePlayerBlock(g.client.DbTx().Ctx, db.CreatePlayerBlockParams{
+		PlayerID:        g.player.DbId,
+		BlockedPlayerID: target.ID,
+		CreatedAt:       time.Now().Unix(),
+	})
+	if err != nil {
+		g.logger.Errorf("Error blocking player %s: %v", target.Name, err)
+		return "", errors.New("Failed to block player (internal server error) - please try again later")
+	}
+
+	// Players can't stay friends with someone they've blocked
+	if err := g.severFriendship(target); err != nil {
+		g.logger.Errorf("Error removing friendship with blocked player %s: %v", target.Name, err)
+	}
+
+	g.blocked.add(target.ID, target.Name)
+	return target.Name, nil
+}
+
+// Lets the named player chat and whisper to our player again. Errors returned are meant to be shown to the player.
+func (g *InGame) unblockPlayer(name string) (string, error) {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to unblock: %v", name, err)
+		return "", errors.New("No player found with that name")
+	}
+
+	unblocked, err := g.client.DbTx().Queries.DeletePlayerBlock(g.client.DbTx().Ctx, db.DeletePlayerBlockParams{
+		PlayerID:        g.player.DbId,
+		BlockedPlayerID: target.ID,
+	})
+	if err != nil {
+		g.logger.Errorf("Error unblocking player %s: %v", target.Name, err)
+		return "", errors.New("Failed to unblock player (internal server error) - please try again later")
+	}
+	if unblocked == 0 {
+		return "", fmt.Errorf("You haven't blocked %s", target.Name)
+	}
+
+	g.blocked.remove(target.ID)
+	return target.Name, nil
+}
+
+// Mutes our player for flooding the chat, and returns an error explaining the mute to them
+func (g *InGame) muteForFlooding(duration time.Duration) error {
+	mute, err := server.IssueUserSanction(g.client.DbTx(), server.ChatFilterActor, g.client.AccountId(), g.player.Name, server.SanctionMute, "Flooding the chat", duration)
+	if err != nil {
+		g.logger.Errorf("Error muting player for flooding the chat: %v", err)
+		return fmt.Errorf("Message not sent: %w", server.ErrChatTooFast)
+	}
+
+	g.logger.Printf("Muted %s for %s for flooding the chat", g.player.Name, duration)
+	return errors.New(mute.Describe(server.SanctionMute.PastTense()))
 }
 
 func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_SporeConsumed) {
@@ -144,8 +482,9 @@ func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_Sp
 	go g.client.SharedGameObjects().Spores.Remove(sporeId)
 
 	g.client.Broadcast(message)
+	g.recordEvent(server.StatsEvent{Kind: server.StatsSporeEaten, PlayerDbId: g.player.DbId})
 
-	go g.syncPlayerBestScore()
+	g.syncPlayerBestScore()
 }
 
 func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
@@ -154,11 +493,7 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 
 		if message.PlayerConsumed.PlayerId == g.client.Id() {
 			g.logger.Println("Player was consumed, respawning")
-			g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
-			})
+			g.respawn()
 		}
 
 		return
@@ -191,13 +526,25 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 	}
 
 	// If we made it this far, the player consumption is valid, so grow the player, remove the consumed other, and broadcast the event
+	g.client.SharedGameObjects().Consumptions.Add(objects.Consumption{
+		ConsumerDbId:   g.player.DbId,
+		ConsumerName:   g.player.Name,
+		ConsumerRadius: g.player.Radius,
+		ConsumedDbId:   other.DbId,
+		ConsumedName:   other.Name,
+		ConsumedRadius: other.Radius,
+		X:              other.X,
+		Y:              other.Y,
+		At:             time.Now(),
+	})
 	g.player.Radius = g.nextRadius(otherMass)
 
 	go g.client.SharedGameObjects().Players.Remove(otherId)
 
 	g.client.Broadcast(message)
+	g.recordEvent(server.StatsEvent{Kind: server.StatsPlayerEaten, PlayerDbId: g.player.DbId, OtherDbId: other.DbId})
 
-	go g.syncPlayerBestScore()
+	g.syncPlayerBestScore()
 }
 
 func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
@@ -213,15 +560,567 @@ func (g *InGame) handleDisconnect(senderId uint64, message *packets.Packet_Disco
 	}
 }
 
+func (g *InGame) handleKickPlayerRequest(senderId uint64, message *packets.Packet_KickPlayerRequest) {
+	if senderId != g.client.Id() {
+		// A moderator has already had their permissions checked by their own client, and wants us gone
+		g.logger.Printf("Kicked by client %d: %s", senderId, message.KickPlayerRequest.Reason)
+		g.client.Kick(fmt.Sprintf("Kicked by a moderator: %s", message.KickPlayerRequest.Reason))
+		return
+	}
+
+	if !g.checkPermission(server.PermissionKick) {
+		return
+	}
+
+	g.respond(g.kickPlayer(message.KickPlayerRequest.Name, message.KickPlayerRequest.Reason))
+}
+
+func (g *InGame) handleMutePlayerRequest(senderId uint64, message *packets.Packet_MutePlayerRequest) {
+	if senderId != g.client.Id() {
+		// The mute has already been recorded by the moderator's client, we just need to let our player know
+		g.logger.Printf("Muted by client %d: %s", senderId, message.MutePlayerRequest.Reason)
+		g.client.SocketSendAs(packets.NewSystemMessage(fmt.Sprintf("You have been muted: %s", message.MutePlayerRequest.Reason)), 0)
+		return
+	}
+
+	if !g.checkPermission(server.PermissionMute) {
+		return
+	}
+
+	duration, err := requestedSanctionDuration(message.MutePlayerRequest.DurationSeconds, message.MutePlayerRequest.Permanent)
+	if err != nil {
+		g.respond(err)
+		return
+	}
+	g.respond(g.mutePlayer(message.MutePlayerRequest.Name, message.MutePlayerRequest.Reason, duration))
+}
+
+func (g *InGame) handleUnmutePlayerRequest(senderId uint64, message *packets.Packet_UnmutePlayerRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	if !g.checkPermission(server.PermissionMute) {
+		return
+	}
+
+	_, err := g.revokeSanctions(message.UnmutePlayerRequest.Name, server.SanctionMute)
+	g.respond(err)
+}
+
+func (g *InGame) handleBanPlayerRequest(senderId uint64, message *packets.Packet_BanPlayerRequest) {
+	if senderId != g.client.Id() {
+		// The ban has already been recorded by the moderator's client, we just need to leave
+		g.logger.Printf("Banned by client %d: %s", senderId, message.BanPlayerRequest.Reason)
+		g.client.Kick(fmt.Sprintf("You have been banned: %s", message.BanPlayerRequest.Reason))
+		return
+	}
+
+	if !g.checkPermission(server.PermissionBan) {
+		return
+	}
+
+	duration, err := requestedSanctionDuration(message.BanPlayerRequest.DurationSeconds, message.BanPlayerRequest.Permanent)
+	if err != nil {
+		g.respond(err)
+		return
+	}
+	g.respond(g.banPlayer(message.BanPlayerRequest.Name, message.BanPlayerRequest.Reason, duration, message.BanPlayerRequest.BanIp))
+}
+
+func (g *InGame) handleUnbanPlayerRequest(senderId uint64, message *packets.Packet_UnbanPlayerRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	if !g.checkPermission(server.PermissionBan) {
+		return
+	}
+
+	_, err := g.revokeSanctions(message.UnbanPlayerRequest.Name, server.SanctionBan)
+	g.respond(err)
+}
+
+func (g *InGame) handleAnnounceRequest(senderId uint64, message *packets.Packet_AnnounceRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	if !g.checkPermission(server.PermissionAnnounce) {
+		return
+	}
+
+	g.announce(message.AnnounceRequest.Msg)
+}
+
+func (g *InGame) handleSearchChatRequest(senderId uint64, message *packets.Packet_SearchChatRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	if !g.checkPermission(server.PermissionViewChatLog) {
+		return
+	}
+
+	request := message.SearchChatRequest
+	var since, until time.Time
+	if request.Since > 0 {
+		since = time.Unix(request.Since, 0)
+	}
+	if request.Until > 0 {
+		until = time.Unix(request.Until, 0)
+	}
+
+	records, err := g.searchChat(request.PlayerName, since, until, int64(request.Limit))
+	if err != nil {
+		g.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+
+	entries := make([]*packets.ChatLogEntryMessage, 0, len(records))
+	for _, record := range records {
+		entries = append(entries, newChatLogEntry(record))
+	}
+	g.client.SocketSend(packets.NewChatLog(entries))
+}
+
+func (g *InGame) handleBlockPlayerRequest(senderId uint64, message *packets.Packet_BlockPlayerRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	_, err := g.blockPlayer(message.BlockPlayerRequest.Name)
+	g.respond(err)
+}
+
+func (g *InGame) handleUnblockPlayerRequest(senderId uint64, message *packets.Packet_UnblockPlayerRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	_, err := g.unblockPlayer(message.UnblockPlayerRequest.Name)
+	g.respond(err)
+}
+
+func (g *InGame) handleAddFriendRequest(senderId uint64, message *packets.Packet_AddFriendRequest) {
+	if senderId != g.client.Id() {
+		// Another player has asked to be friends with ours, and has already checked they're allowed to
+		g.client.SocketSendAs(message, senderId)
+		return
+	}
+
+	_, err := g.addFriend(message.AddFriendRequest.Name)
+	g.respond(err)
+}
+
+func (g *InGame) handleAcceptFriendRequest(senderId uint64, message *packets.Packet_AcceptFriendRequest) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+		return
+	}
+
+	_, err := g.acceptFriend(message.AcceptFriendRequest.Name)
+	g.respond(err)
+}
+
+func (g *InGame) handleRemoveFriendRequest(senderId uint64, message *packets.Packet_RemoveFriendRequest) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+		return
+	}
+
+	_, err := g.removeFriend(message.RemoveFriendRequest.Name)
+	g.respond(err)
+}
+
+func (g *InGame) handleFriendPresence(senderId uint64, message *packets.Packet_FriendPresence) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+	}
+}
+
+func (g *InGame) handleJoinFriendRequest(senderId uint64, message *packets.Packet_JoinFriendRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	g.respond(g.joinFriend(message.JoinFriendRequest.Name))
+}
+
+func (g *InGame) handleStatsRequest(senderId uint64, message *packets.Packet_StatsRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	sendPlayerStats(g.client, message.StatsRequest.Name)
+}
+
+func (g *InGame) handleReportPlayerRequest(senderId uint64, message *packets.Packet_ReportPlayerRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	request := message.ReportPlayerRequest
+	details := strings.TrimSpace(request.Details)
+	if utf8.RuneCountInString(details) > maxReportDetailsLength {
+		g.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Report details must be at most %d characters", maxReportDetailsLength)))
+		return
+	}
+
+	if _, known := packets.ReportCategory_name[int32(request.Category)]; !known {
+		g.client.SocketSend(packets.NewDenyResponse("Unknown report category"))
+		return
+	}
+
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, request.Name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to report: %v", request.Name, err)
+		g.client.SocketSend(packets.NewDenyResponse("No player found with that name"))
+		return
+	}
+
+	if target.ID == g.player.DbId {
+		g.client.SocketSend(packets.NewDenyResponse("You can't report yourself"))
+		return
+	}
+
+	report, err := server.FilePlayerReport(g.client.DbTx(), g.client.SharedGameObjects(), g.player.DbId, g.player.Name, target, int64(request.Category), details)
+	if errors.Is(err, server.ErrTooManyReports) {
+		g.client.SocketSend(packets.NewDenyResponse("You've sent too many reports recently - please try again later"))
+		return
+	}
+	if err != nil {
+		g.logger.Errorf("Error filing report against player %s: %v", target.Name, err)
+		g.client.SocketSend(packets.NewDenyResponse("Failed to send report (internal server error) - please try again later"))
+		return
+	}
+
+	g.logger.Printf("Filed report %d against player %s (%v)", report.ID, target.Name, request.Category)
+	g.client.SocketSend(packets.NewOkResponse())
+}
+
+// How long a sanction requested by a moderator's client should last, where zero means forever. Permanent sanctions have
+// to be asked for explicitly, so a request which leaves out the duration isn't mistaken for one.
+func requestedSanctionDuration(seconds int64, permanent bool) (time.Duration, error) {
+	if permanent {
+		return 0, nil
+	}
+	if seconds <= 0 {
+		return 0, errors.New("Sanctions must last at least a second, unless they're permanent")
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// Replies to a request from our client with an OK if it succeeded, or the error if it didn't
+func (g *InGame) respond(err error) {
+	if err != nil {
+		g.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+	g.client.SocketSend(packets.NewOkResponse())
+}
+
+// The moderation actions below are shared by the moderator request packets and chat commands. They assume our
+// client's permissions have already been checked, and the errors they return are meant to be shown to the player.
+
+func (g *InGame) kickPlayer(name, reason string) error {
+	targetId, _, found := g.findPlayerByName(name)
+	if !found {
+		return errors.New("No player with that name is in the game")
+	}
+
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to kick: %v", name, err)
+		return errors.New("Failed to kick player (internal server error) - please try again later")
+	}
+	if err := g.checkOutranks(target.UserID); err != nil {
+		return err
+	}
+
+	g.logger.Printf("Kicking player %s: %s", name, reason)
+	g.client.PassToPeer(&packets.Packet_KickPlayerRequest{
+		KickPlayerRequest: &packets.KickPlayerRequestMessage{Name: name, Reason: reason},
+	}, targetId)
+
+	if err := server.LogModerationAction(g.client.DbTx(), g.actorName(), "kick", name, reason); err != nil {
+		g.logger.Errorf("Error logging kick: %v", err)
+	}
+	return nil
+}
+
+func (g *InGame) mutePlayer(name, reason string, duration time.Duration) error {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to mute: %v", name, err)
+		return errors.New("No player found with that name")
+	}
+	if err := g.checkOutranks(target.UserID); err != nil {
+		return err
+	}
+
+	_, err = server.IssueUserSanction(g.client.DbTx(), g.actorName(), target.UserID, target.Name, server.SanctionMute, reason, duration)
+	if err != nil {
+		g.logger.Errorf("Error muting player %s: %v", target.Name, err)
+		return errors.New("Failed to mute player (internal server error) - please try again later")
+	}
+
+	g.logger.Printf("Muted player %s for %v: %s", target.Name, duration, reason)
+	if targetId, _, online := g.findPlayerByName(target.Name); online {
+		g.client.PassToPeer(&packets.Packet_MutePlayerRequest{
+			MutePlayerRequest: &packets.MutePlayerRequestMessage{
+				Name:            target.Name,
+				Reason:          reason,
+				DurationSeconds: int64(duration.Seconds()),
+				Permanent:       duration <= 0,
+			},
+		}, targetId)
+	}
+	return nil
+}
+
+func (g *InGame) banPlayer(name, reason string, duration time.Duration, banIp bool) error {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to ban: %v", name, err)
+		return errors.New("No player found with that name")
+	}
+	if err := g.checkOutranks(target.UserID); err != nil {
+		return err
+	}
+
+	genericFailErr := errors.New("Failed to ban player (internal server error) - please try again later")
+	actor := g.actorName()
+
+	if _, err := server.IssueUserSanction(g.client.DbTx(), actor, target.UserID, target.Name, server.SanctionBan, reason, duration); err != nil {
+		g.logger.Errorf("Error banning player %s: %v", target.Name, err)
+		return genericFailErr
+	}
+	g.logger.Printf("Banned player %s for %v: %s", target.Name, duration, reason)
+
+	if banIp {
+		ipAddress, err := g.client.DbTx().Queries.GetLastLoginIp(g.client.DbTx().Ctx, target.UserID)
+		if err != nil {
+			g.logger.Errorf("Error getting last known IP address of player %s: %v", target.Name, err)
+			return genericFailErr
+		}
+
+		if _, err := server.IssueIpBan(g.client.DbTx(), actor, ipAddress, reason, duration); err != nil {
+			g.logger.Errorf("Error banning IP address of player %s: %v", target.Name, err)
+			return genericFailErr
+		}
+		g.logger.Printf("Banned IP address of player %s", target.Name)
+	}
+
+	if targetId, _, online := g.findPlayerByName(target.Name); online {
+		g.client.PassToPeer(&packets.Packet_BanPlayerRequest{
+			BanPlayerRequest: &packets.BanPlayerRequestMessage{
+				Name:            target.Name,
+				Reason:          reason,
+				DurationSeconds: int64(duration.Seconds()),
+				BanIp:           banIp,
+				Permanent:       duration <= 0,
+			},
+		}, targetId)
+	}
+	return nil
+}
+
+// Lifts all sanctions of the given kind from the named player, and for bans, any ban on the IP address they last
+// logged in from too. Returns a description of what was lifted for the moderator.
+func (g *InGame) revokeSanctions(name string, kind server.SanctionKind) (string, error) {
+	target, err := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+	if err != nil {
+		g.logger.Errorf("Error getting player %s to lift %s from: %v", name, kind, err)
+		return "", errors.New("No player found with that name")
+	}
+	if err := g.checkOutranks(target.UserID); err != nil {
+		return "", err
+	}
+
+	var revoked, ipRevoked int64
+	if kind == server.SanctionBan {
+		revoked, ipRevoked, err = server.RevokeUserBans(g.client.DbTx(), g.actorName(), target.UserID, target.Name)
+	} else {
+		revoked, err = server.RevokeUserSanctions(g.client.DbTx(), g.actorName(), target.UserID, target.Name, kind)
+	}
+	if err != nil {
+		g.logger.Errorf("Error lifting %s from player %s: %v", kind, target.Name, err)
+		return "", errors.New("Failed to update player (internal server error) - please try again later")
+	}
+
+	if revoked == 0 && ipRevoked == 0 {
+		return "", fmt.Errorf("Player has no %s to lift", kind)
+	}
+
+	g.logger.Printf("Lifted %d %s(s) from player %s, and %d ban(s) from their IP address", revoked, kind, target.Name, ipRevoked)
+	description := fmt.Sprintf("Lifted %d %s(s) from %s", revoked, kind, target.Name)
+	if ipRevoked > 0 {
+		description += fmt.Sprintf(", and %d ban(s) on the IP address they last logged in from", ipRevoked)
+	}
+	return description, nil
+}
+
+// Finds the recorded chat messages sent by the named player, or by anyone if the name is empty, most recent first.
+// Zero times leave that end of the time range open, and the limit is capped at maxChatSearchResults.
+func (g *InGame) searchChat(name string, since, until time.Time, limit int64) ([]db.ChatMessage, error) {
+	if limit <= 0 || limit > maxChatSearchResults {
+		limit = maxChatSearchResults
+	}
+
+	sentAfter, sentBefore := int64(0), int64(math.MaxInt64)
+	if !since.IsZero() {
+		sentAfter = since.Unix()
+	}
+	if !until.IsZero() {
+		sentBefore = until.Unix()
+	}
+
+	var records []db.ChatMessage
+	var err error
+	if name == "" {
+		records, err = g.client.DbTx().Queries.SearchChatMessages(g.client.DbTx().Ctx, db.SearchChatMessagesParams{
+			SentAt:   sentAfter,
+			SentAt_2: sentBefore,
+			Limit:    limit,
+		})
+	} else {
+		target, findErr := g.client.DbTx().Queries.GetPlayerByExactName(g.client.DbTx().Ctx, name)
+		if findErr != nil {
+			g.logger.Errorf("Error getting player %s to search chat for: %v", name, findErr)
+			return nil, errors.New("No player found with that name")
+		}
+		records, err = g.client.DbTx().Queries.SearchChatMessagesByPlayer(g.client.DbTx().Ctx, db.SearchChatMessagesByPlayerParams{
+			SenderPlayerID: target.ID,
+			SentAt:         sentAfter,
+			SentAt_2:       sentBefore,
+			Limit:          limit,
+		})
+	}
+	if err != nil {
+		g.logger.Errorf("Error searching chat: %v", err)
+		return nil, errors.New("Failed to search chat (internal server error) - please try again later")
+	}
+
+	target := name
+	if target == "" {
+		target = "everyone"
+	}
+	describeTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "any"
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	details := fmt.Sprintf("since: %s, until: %s, results: %d", describeTime(since), describeTime(until), len(records))
+	if err := server.LogModerationAction(g.client.DbTx(), g.actorName(), "search_chat", target, details); err != nil {
+		g.logger.Errorf("Error logging chat search: %v", err)
+	}
+
+	return records, nil
+}
+
+func (g *InGame) announce(msg string) {
+	g.logger.Printf("Announcing: %s", msg)
+	announcement := packets.NewSystemMessage(fmt.Sprintf("[Announcement] %s", msg))
+	g.client.Broadcast(announcement)
+	g.client.SocketSendAs(announcement, 0)
+
+	if err := server.LogModerationAction(g.client.DbTx(), g.actorName(), "announce", "everyone", msg); err != nil {
+		g.logger.Errorf("Error logging announcement: %v", err)
+	}
+}
+
+// Checks whether our client is allowed to do something, and lets them know if they're not
+func (g *InGame) checkPermission(permission server.Permission) bool {
+	if err := server.CheckPermission(g.client, permission); err != nil {
+		g.logger.Printf("Denied request requiring permission %d: %v", permission, err)
+		g.client.SocketSend(packets.NewDenyResponse("You don't have permission to do that"))
+		return false
+	}
+	return true
+}
+
+// Checks our client's account is more senior than the target's, returning an error to show the player if it isn't
+func (g *InGame) checkOutranks(targetUserId int64) error {
+	err := server.CheckOutranks(g.client, targetUserId)
+	if errors.Is(err, server.ErrNotOutranked) {
+		return errors.New("You can't take action against someone whose role is as senior as yours")
+	}
+	if err != nil {
+		g.logger.Errorf("Error comparing roles with user %d: %v", targetUserId, err)
+		return errors.New("Failed to check roles (internal server error) - please try again later")
+	}
+	return nil
+}
+
+// The name to record in the moderation log for actions taken by our client
+func (g *InGame) actorName() string {
+	user, err := g.client.DbTx().Queries.GetUserById(g.client.DbTx().Ctx, g.client.AccountId())
+	if err != nil {
+		g.logger.Errorf("Error getting user to record in moderation log, using player name instead: %v", err)
+		return g.player.Name
+	}
+	return user.Username
+}
+
+// Finds the ID of the client whose player has the given name (case-insensitive), if they're in the game
+func (g *InGame) findPlayerByName(name string) (uint64, *objects.Player, bool) {
+	var foundId uint64
+	var foundPlayer *objects.Player
+	g.client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if foundPlayer == nil && strings.EqualFold(player.Name, name) {
+			foundId, foundPlayer = playerId, player
+		}
+	})
+	return foundId, foundPlayer, foundPlayer != nil
+}
+
+func (g *InGame) respawn() {
+	player := &objects.Player{
+		Name:      g.player.Name,
+		DbId:      g.player.DbId,
+		BestScore: g.player.BestScore,
+		Color:     g.player.Color,
+		Arena:     g.player.Arena,
+		Level:     g.player.Level,
+		Badge:     g.player.Badge,
+	}
+
+	// Pick up any changes made to the player's profile since they logged in
+	dbPlayer, err := g.client.DbTx().Queries.GetPlayerById(g.client.DbTx().Ctx, g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error refreshing player profile, respawning with the old one: %v", err)
+	} else {
+		player.Name = dbPlayer.Name
+		player.Color = int32(dbPlayer.Color)
+	}
+
+	g.respawning = true
+	g.client.SetState(&InGame{
+		player:       player,
+		blocked:      g.blocked,
+		achievements: g.achievements,
+		respawned:    true,
+	})
+}
+
 func (g *InGame) playerUpdateLoop(ctx context.Context) {
 	const delta float64 = 0.05
 	ticker := time.NewTicker(time.Duration(delta*1000) * time.Millisecond)
 	defer ticker.Stop()
 
+	survivalTicker := time.NewTicker(time.Second)
+	defer survivalTicker.Stop()
+
 	for {
 		select {
 		case <-ticker.C:
+			start := time.Now()
 			g.syncPlayer(delta)
+			metrics.TickDuration.ObserveSince(start)
+		case <-survivalTicker.C:
+			g.checkSurvivalAchievements()
 		case <-ctx.Done():
 			return
 		}
@@ -232,6 +1131,7 @@ func (g *InGame) syncPlayer(delta float64) {
 	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
 	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
 
+	g.distanceTraveled += math.Hypot(newX-g.player.X, newY-g.player.Y)
 	g.player.X = newX
 	g.player.Y = newY
 
@@ -331,14 +1231,46 @@ func (g *InGame) nextRadius(massDiff float64) float64 {
 
 func (g *InGame) syncPlayerBestScore() {
 	currentScore := int64(math.Round(radToMass(g.player.Radius)))
-	if currentScore > g.player.BestScore {
-		g.player.BestScore = currentScore
-		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
-			ID:        g.player.DbId,
-			BestScore: g.player.BestScore,
-		})
-		if err != nil {
-			g.logger.Printf("Error updating player best score: %v", err)
-		}
+	if currentScore > g.peakScore {
+		g.peakScore = currentScore
+		g.recordEvent(server.StatsEvent{Kind: server.StatsMassReached, PlayerDbId: g.player.DbId, Amount: float64(currentScore)})
+	}
+	if currentScore <= g.player.BestScore {
+		return
+	}
+	g.player.BestScore = currentScore
+
+	// The score rises with nearly every spore eaten, so only write it through every so often, and off the goroutine
+	// handling our client's packets. It's always written when the player leaves this state.
+	if time.Since(g.bestScoreSavedAt) >= bestScoreSaveInterval {
+		g.savedBestScore = currentScore
+		g.bestScoreSavedAt = time.Now()
+		go g.saveBestScore(g.player.DbId, currentScore)
+	}
+}
+
+// Writes a player's best score to the database, unless it's already higher there. Saves made in the background can
+// finish out of order, so this never lowers the score.
+func (g *InGame) saveBestScore(playerId int64, bestScore int64) {
+	err := g.client.DbTx().Queries.RaisePlayerBestScore(g.client.DbTx().Ctx, db.RaisePlayerBestScoreParams{
+		BestScore:   bestScore,
+		ID:          playerId,
+		BestScore_2: bestScore,
+	})
+	if err != nil {
+		g.logger.Errorf("Error updating player best score: %v", err)
+	}
+}
+
+// Saves the highest score our player reached during this life, for the daily, weekly, monthly and season hiscores
+func (g *InGame) recordScore() {
+	err := g.client.DbTx().Queries.CreateScoreHistory(g.client.DbTx().Ctx, db.CreateScoreHistoryParams{
+		PlayerID:  g.player.DbId,
+		Score:     g.peakScore,
+		StartedAt: g.spawnedAt.Unix(),
+		EndedAt:   time.Now().Unix(),
+	})
+	if err != nil {
+		g.logger.Errorf("Error recording score history: %v", err)
 	}
 }
@@ -0,0 +1,153 @@
+package states
+
+import (
+	"errors"
+	"fmt"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+)
+
+// Describes how far the player has come and every cosmetic in the game, showing which ones they own and are wearing
+func newProgressMessage(progress db.PlayerProgress, color int32) *packets.Packet_Progress {
+	level := server.LevelForXp(progress.Xp)
+	cosmetics := make([]*packets.CosmeticMessage, 0, len(server.Cosmetics))
+	for _, cosmetic := range server.Cosmetics {
+		equipped := cosmetic.Id == progress.Badge
+		if cosmetic.Kind == packets.CosmeticKind_COSMETIC_KIND_COLOR {
+			equipped = cosmetic.Color == uint32(color)
+		}
+
+		cosmetics = append(cosmetics, &packets.CosmeticMessage{
+			Id:       cosmetic.Id,
+			Kind:     cosmetic.Kind,
+			Name:     cosmetic.Name,
+			Color:    int32(cosmetic.Color),
+			Level:    uint32(cosmetic.Level),
+			Owned:    cosmetic.Level <= level,
+			Equipped: equipped,
+		})
+	}
+
+	nextLevelXp := server.XpForLevel(level + 1)
+	if level >= server.MaxLevel {
+		nextLevelXp = server.XpForLevel(level)
+	}
+
+	return &packets.Packet_Progress{
+		Progress: &packets.ProgressMessage{
+			Level:       uint32(level),
+			Xp:          uint64(progress.Xp),
+			LevelXp:     uint64(server.XpForLevel(level)),
+			NextLevelXp: uint64(nextLevelXp),
+			Cosmetics:   cosmetics,
+		},
+	}
+}
+
+// The name of the badge to show alongside a player's name, as long as they're still allowed to wear it
+func badgeName(badgeId string, level int64) string {
+	badge, exists := server.FindCosmetic(badgeId)
+	if !exists || badge.Kind != packets.CosmeticKind_COSMETIC_KIND_BADGE || badge.Level > level {
+		return ""
+	}
+	return badge.Name
+}
+
+// Checks a color the player wants to use is one they've unlocked
+func validateOwnedColor(color int32, level int64) error {
+	if err := validateColor(color); err != nil {
+		return err
+	}
+
+	cosmetic, exists := server.FindColorCosmetic(color)
+	if !exists {
+		return errors.New("not one of the available colors")
+	}
+	if cosmetic.Level > level {
+		return fmt.Errorf("%s unlocks at level %d", cosmetic.Name, cosmetic.Level)
+	}
+	return nil
+}
+
+// Works out our player's level and badge, and lets the client know how far they've come
+func (g *InGame) loadProgress() {
+	progress, err := server.GetPlayerProgress(g.client.DbTx(), g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error loading progress, starting from level 1: %v", err)
+		progress = db.PlayerProgress{PlayerID: g.player.DbId}
+	}
+
+	g.player.Level = server.LevelForXp(progress.Xp)
+	g.player.Badge = badgeName(progress.Badge, g.player.Level)
+	g.client.SocketSend(newProgressMessage(progress, g.player.Color))
+}
+
+// Puts on the cosmetic with the given ID, or takes off our player's badge if the ID is empty. Errors returned are
+// meant to be shown to the player as they are.
+func (g *InGame) equipCosmetic(id string) error {
+	genericFailMessage := errors.New("Failed to equip cosmetic (internal server error) - please try again later")
+	dbTx := g.client.DbTx()
+
+	if id == "" {
+		if err := dbTx.Queries.SetPlayerBadge(dbTx.Ctx, db.SetPlayerBadgeParams{PlayerID: g.player.DbId}); err != nil {
+			g.logger.Errorf("Error removing badge: %v", err)
+			return genericFailMessage
+		}
+		g.player.Badge = ""
+		return nil
+	}
+
+	cosmetic, exists := server.FindCosmetic(id)
+	if !exists {
+		return errors.New("No cosmetic found with that ID")
+	}
+	if cosmetic.Level > g.player.Level {
+		return fmt.Errorf("You need to reach level %d to use %s", cosmetic.Level, cosmetic.Name)
+	}
+
+	switch cosmetic.Kind {
+	case packets.CosmeticKind_COSMETIC_KIND_COLOR:
+		err := dbTx.Queries.UpdatePlayerColor(dbTx.Ctx, db.UpdatePlayerColorParams{
+			Color: int64(int32(cosmetic.Color)),
+			ID:    g.player.DbId,
+		})
+		if err != nil {
+			g.logger.Errorf("Error changing color to %s: %v", cosmetic.Id, err)
+			return genericFailMessage
+		}
+		g.player.Color = int32(cosmetic.Color)
+	case packets.CosmeticKind_COSMETIC_KIND_BADGE:
+		err := dbTx.Queries.SetPlayerBadge(dbTx.Ctx, db.SetPlayerBadgeParams{
+			PlayerID: g.player.DbId,
+			Badge:    cosmetic.Id,
+		})
+		if err != nil {
+			g.logger.Errorf("Error changing badge to %s: %v", cosmetic.Id, err)
+			return genericFailMessage
+		}
+		g.player.Badge = cosmetic.Name
+	}
+
+	return nil
+}
+
+func (g *InGame) handleEquipCosmeticRequest(senderId uint64, message *packets.Packet_EquipCosmeticRequest) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	if err := g.equipCosmetic(message.EquipCosmeticRequest.Id); err != nil {
+		g.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+
+	// Let the client know what they're wearing now. Everyone else finds out with the next player update.
+	progress, err := server.GetPlayerProgress(g.client.DbTx(), g.player.DbId)
+	if err != nil {
+		g.logger.Errorf("Error getting progress after equipping %s: %v", message.EquipCosmeticRequest.Id, err)
+		g.client.SocketSend(packets.NewOkResponse())
+		return
+	}
+	g.client.SocketSend(newProgressMessage(progress, g.player.Color))
+}
@@ -0,0 +1,109 @@
+package states
+
+import (
+	"errors"
+	"fmt"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+// How many of the players someone has eaten the most, and been eaten by the most, are shown on their profile
+const statsKillListLength = 5
+
+// Looks up the named player's stats profile. Errors returned are meant to be shown to the player as they are.
+func playerStats(client server.ClientInterfacer, name string) (*packets.PlayerStatsMessage, error) {
+	dbTx := client.DbTx()
+	player, err := dbTx.Queries.GetPlayerByExactName(dbTx.Ctx, name)
+	if err != nil {
+		logger.Errorf("Error getting player %s to look up stats: %v", name, err)
+		return nil, errors.New("No player found with that name")
+	}
+
+	genericFailMessage := errors.New("Failed to get stats (internal server error) - please try again later")
+
+	lifetime, err := client.Stats().Lifetime(dbTx, player.ID)
+	if err != nil {
+		logger.Errorf("Error getting lifetime stats of player %s: %v", player.Name, err)
+		return nil, genericFailMessage
+	}
+
+	victims, err := dbTx.Queries.GetTopVictims(dbTx.Ctx, db.GetTopVictimsParams{
+		KillerPlayerID: player.ID,
+		Limit:          statsKillListLength,
+	})
+	if err != nil {
+		logger.Errorf("Error getting players eaten by %s: %v", player.Name, err)
+		return nil, genericFailMessage
+	}
+
+	killers, err := dbTx.Queries.GetTopKillers(dbTx.Ctx, db.GetTopKillersParams{
+		VictimPlayerID: player.ID,
+		Limit:          statsKillListLength,
+	})
+	if err != nil {
+		logger.Errorf("Error getting players who ate %s: %v", player.Name, err)
+		return nil, genericFailMessage
+	}
+
+	stats := &packets.PlayerStatsMessage{
+		Name:        player.Name,
+		Lifetime:    newStatsTotalsMessage(lifetime),
+		MostEaten:   make([]*packets.KillCountMessage, 0, len(victims)),
+		MostEatenBy: make([]*packets.KillCountMessage, 0, len(killers)),
+	}
+
+	if session, online := client.Stats().Session(player.ID); online {
+		stats.Online = true
+		stats.Session = newStatsTotalsMessage(session)
+	}
+
+	for _, victim := range victims {
+		stats.MostEaten = append(stats.MostEaten, &packets.KillCountMessage{Name: victim.Name, Kills: uint64(victim.Kills)})
+	}
+	for _, killer := range killers {
+		stats.MostEatenBy = append(stats.MostEatenBy, &packets.KillCountMessage{Name: killer.Name, Kills: uint64(killer.Kills)})
+	}
+
+	return stats, nil
+}
+
+func newStatsTotalsMessage(totals server.StatsTotals) *packets.StatsTotalsMessage {
+	return &packets.StatsTotalsMessage{
+		Sessions:         uint64(totals.Sessions),
+		SporesEaten:      uint64(totals.SporesEaten),
+		PlayersEaten:     uint64(totals.PlayersEaten),
+		TimesEaten:       uint64(totals.TimesEaten),
+		SecondsAlive:     uint64(totals.SecondsAlive),
+		MaxMass:          uint64(totals.MaxMass),
+		DistanceTraveled: totals.DistanceTraveled,
+	}
+}
+
+// Looks up the player's stats and sends them to the client, or tells the client why it couldn't
+func sendPlayerStats(client server.ClientInterfacer, name string) {
+	stats, err := playerStats(client, name)
+	if err != nil {
+		client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+	client.SocketSend(&packets.Packet_PlayerStats{PlayerStats: stats})
+}
+
+// Describes a set of totals on one line, for chat commands
+func describeStatsTotals(totals *packets.StatsTotalsMessage) string {
+	alive := time.Duration(totals.SecondsAlive) * time.Second
+	return fmt.Sprintf("%d spores eaten, %d players eaten, eaten %d times, alive for %s, max mass %d, traveled %.0f",
+		totals.SporesEaten, totals.PlayersEaten, totals.TimesEaten, alive, totals.MaxMass, totals.DistanceTraveled)
+}
+
+// Describes a list of kill counts on one line, e.g. "bob (3), alice (1)"
+func describeKillCounts(counts []*packets.KillCountMessage) string {
+	described := make([]string, 0, len(counts))
+	for _, count := range counts {
+		described = append(described, fmt.Sprintf("%s (%d)", count.Name, count.Kills))
+	}
+	return strings.Join(described, ", ")
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"server/internal/server/db"
+	"server/internal/server/logging"
+	"sync"
+	"time"
+)
+
+var statsLogger = logging.Subsystem("stats")
+
+// How many stats events can be waiting for the aggregator before new ones are dropped
+const statsEventBuffer = 1024
+
+type StatsEventKind int
+
+const (
+	// The player joined the game
+	StatsSessionStarted StatsEventKind = iota
+
+	// The player spawned, either on joining the game or after being eaten
+	StatsLifeStarted
+
+	StatsSporeEaten
+
+	// The player ate the player given by OtherDbId
+	StatsPlayerEaten
+
+	// The player reached the mass given by Amount
+	StatsMassReached
+
+	// The player's life ended, having traveled the distance given by Amount
+	StatsLifeEnded
+
+	// The player left the game
+	StatsSessionEnded
+)
+
+// Something that happened to a player in the game which counts towards their stats
+type StatsEvent struct {
+	Kind       StatsEventKind
+	PlayerDbId int64
+	OtherDbId  int64
+	Amount     float64
+	At         time.Time
+}
+
+// A player's totals over one or more sessions
+type StatsTotals struct {
+	Sessions         int64
+	SporesEaten      int64
+	PlayersEaten     int64
+	TimesEaten       int64
+	SecondsAlive     int64
+	MaxMass          int64
+	DistanceTraveled float64
+}
+
+// Combines the totals with another set, e.g. to add the current session to a player's lifetime
+func (t StatsTotals) Add(other StatsTotals) StatsTotals {
+	return StatsTotals{
+		Sessions:         t.Sessions + other.Sessions,
+		SporesEaten:      t.SporesEaten + other.SporesEaten,
+		PlayersEaten:     t.PlayersEaten + other.PlayersEaten,
+		TimesEaten:       t.TimesEaten + other.TimesEaten,
+		SecondsAlive:     t.SecondsAlive + other.SecondsAlive,
+		MaxMass:          max(t.MaxMass, other.MaxMass),
+		DistanceTraveled: t.DistanceTraveled + other.DistanceTraveled,
+	}
+}
+
+// The stats of a session still in progress
+type statsSession struct {
+	totals      StatsTotals
+	startedAt   time.Time
+	lifeStarted time.Time
+	alive       bool
+}
+
+// Totals up the stats events sent by players in the game, saving each session to the database when it ends and
+// granting the player XP for it
+type StatsAggregator struct {
+	events   chan StatsEvent
+	dbTx     *DbTx
+	sessions map[int64]*statsSession
+	mux      sync.Mutex
+}
+
+func NewStatsAggregator(dbTx *DbTx) *StatsAggregator {
+	return &StatsAggregator{
+		events:   make(chan StatsEvent, statsEventBuffer),
+		dbTx:     dbTx,
+		sessions: make(map[int64]*statsSession),
+	}
+}
+
+// Queue the event for the aggregator without waiting for it, dropping it if the aggregator has fallen behind
+func (a *StatsAggregator) Emit(event StatsEvent) {
+	if event.At.IsZero() {
+		event.At = time.Now()
+	}
+
+	select {
+	case a.events <- event:
+	default:
+		statsLogger.Warnf("Stats event queue full, dropping event %d for player %d", event.Kind, event.PlayerDbId)
+	}
+}
+
+// The totals of the player's session so far, if they're in the game
+func (a *StatsAggregator) Session(playerDbId int64) (StatsTotals, bool) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	session, exists := a.sessions[playerDbId]
+	if !exists {
+		return StatsTotals{}, false
+	}
+
+	totals := session.totals
+	if session.alive {
+		totals.SecondsAlive += int64(time.Since(session.lifeStarted).Seconds())
+	}
+	return totals, true
+}
+
+func (a *StatsAggregator) Run() {
+	for event := range a.events {
+		a.handle(event)
+	}
+}
+
+func (a *StatsAggregator) handle(event StatsEvent) {
+	a.mux.Lock()
+
+	if event.Kind == StatsSessionStarted {
+		a.sessions[event.PlayerDbId] = &statsSession{
+			totals:    StatsTotals{Sessions: 1},
+			startedAt: event.At,
+		}
+		a.mux.Unlock()
+		return
+	}
+
+	session, exists := a.sessions[event.PlayerDbId]
+	if !exists {
+		a.mux.Unlock()
+		statsLogger.Warnf("Stats event %d for player %d, who has no session", event.Kind, event.PlayerDbId)
+		return
+	}
+
+	switch event.Kind {
+	case StatsLifeStarted:
+		session.lifeStarted = event.At
+		session.alive = true
+	case StatsSporeEaten:
+		session.totals.SporesEaten++
+	case StatsPlayerEaten:
+		session.totals.PlayersEaten++
+		if victim, exists := a.sessions[event.OtherDbId]; exists {
+			victim.totals.TimesEaten++
+		}
+	case StatsMassReached:
+		session.totals.MaxMass = max(session.totals.MaxMass, int64(event.Amount))
+	case StatsLifeEnded:
+		if session.alive {
+			session.totals.SecondsAlive += int64(event.At.Sub(session.lifeStarted).Seconds())
+			session.alive = false
+		}
+		session.totals.DistanceTraveled += event.Amount
+	case StatsSessionEnded:
+		delete(a.sessions, event.PlayerDbId)
+	}
+
+	a.mux.Unlock()
+
+	// Save to the database outside the lock, so players looking up stats don't have to wait for it
+	switch event.Kind {
+	case StatsPlayerEaten:
+		a.recordKill(event)
+	case StatsSessionEnded:
+		a.saveSession(event.PlayerDbId, session, event.At)
+	}
+}
+
+func (a *StatsAggregator) recordKill(event StatsEvent) {
+	err := a.dbTx.Queries.RecordPlayerKill(a.dbTx.Ctx, db.RecordPlayerKillParams{
+		KillerPlayerID: event.PlayerDbId,
+		VictimPlayerID: event.OtherDbId,
+		LastKilledAt:   event.At.Unix(),
+	})
+	if err != nil {
+		statsLogger.Errorf("Error recording player %d eating player %d: %v", event.PlayerDbId, event.OtherDbId, err)
+	}
+}
+
+func (a *StatsAggregator) saveSession(playerDbId int64, session *statsSession, endedAt time.Time) {
+	err := a.dbTx.Queries.CreateSessionStats(a.dbTx.Ctx, db.CreateSessionStatsParams{
+		PlayerID:         playerDbId,
+		StartedAt:        session.startedAt.Unix(),
+		EndedAt:          endedAt.Unix(),
+		SporesEaten:      session.totals.SporesEaten,
+		PlayersEaten:     session.totals.PlayersEaten,
+		TimesEaten:       session.totals.TimesEaten,
+		SecondsAlive:     session.totals.SecondsAlive,
+		MaxMass:          session.totals.MaxMass,
+		DistanceTraveled: session.totals.DistanceTraveled,
+	})
+	if err != nil {
+		statsLogger.Errorf("Error saving stats session of player %d: %v", playerDbId, err)
+	}
+
+	xp := SessionXp(session.totals)
+	if xp <= 0 {
+		return
+	}
+	err = a.dbTx.Queries.AddPlayerXp(a.dbTx.Ctx, db.AddPlayerXpParams{
+		PlayerID: playerDbId,
+		Xp:       xp,
+	})
+	if err != nil {
+		statsLogger.Errorf("Error granting %d XP to player %d: %v", xp, playerDbId, err)
+	}
+}
+
+// The player's totals over every session they've played, including the one they're in now if they're in the game
+func (a *StatsAggregator) Lifetime(dbTx *DbTx, playerDbId int64) (StatsTotals, error) {
+	stored, err := dbTx.Queries.GetLifetimeStats(dbTx.Ctx, playerDbId)
+	if err != nil {
+		return StatsTotals{}, err
+	}
+
+	lifetime := StatsTotals{
+		Sessions:         stored.Sessions,
+		SporesEaten:      stored.SporesEaten,
+		PlayersEaten:     stored.PlayersEaten,
+		TimesEaten:       stored.TimesEaten,
+		SecondsAlive:     stored.SecondsAlive,
+		MaxMass:          stored.MaxMass,
+		DistanceTraveled: stored.DistanceTraveled,
+	}
+	if session, exists := a.Session(playerDbId); exists {
+		lifetime = lifetime.Add(session)
+	}
+	return lifetime, nil
+}
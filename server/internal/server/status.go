@@ -0,0 +1,113 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"sort"
+	"time"
+)
+
+// What an admin sees of a player in the game
+type PlayerSummary struct {
+	Name      string `json:"name"`
+	Arena     string `json:"arena"`
+	Mass      int64  `json:"mass"`
+	BestScore int64  `json:"best_score"`
+	Level     int64  `json:"level"`
+}
+
+// What an admin sees of a connected client
+type ClientSummary struct {
+	Id        uint64         `json:"id"`
+	IpAddress string         `json:"ip_address"`
+	AccountId int64          `json:"account_id,omitempty"`
+	Player    *PlayerSummary `json:"player,omitempty"`
+}
+
+// How busy an arena is
+type ArenaSummary struct {
+	Name      string `json:"name"`
+	Players   int    `json:"players"`
+	TotalMass int64  `json:"total_mass"`
+	Largest   string `json:"largest"`
+	MaxMass   int64  `json:"max_mass"`
+}
+
+// An overview of everything going on in the server
+type ServerStatus struct {
+	UptimeSeconds int64          `json:"uptime_seconds"`
+	Clients       int            `json:"clients"`
+	LoggedIn      int            `json:"logged_in"`
+	Spores        int            `json:"spores"`
+	SporeTarget   int64          `json:"spore_target"`
+	Arenas        []ArenaSummary `json:"arenas"`
+}
+
+// Every connected client, in the order they connected
+func (h *Hub) ClientSummaries() []ClientSummary {
+	clients := make([]ClientSummary, 0, h.Clients.Len())
+	h.Clients.ForEach(func(id uint64, client ClientInterfacer) {
+		summary := ClientSummary{Id: id, IpAddress: client.IpAddress(), AccountId: client.AccountId()}
+		if player, inGame := h.SharedGameObjects.Players.Get(id); inGame {
+			summary.Player = &PlayerSummary{
+				Name:      player.Name,
+				Arena:     player.Arena,
+				Mass:      int64(player.Mass()),
+				BestScore: player.BestScore,
+				Level:     player.Level,
+			}
+		}
+		clients = append(clients, summary)
+	})
+
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].Id < clients[j].Id
+	})
+	return clients
+}
+
+// Every arena with players in it, in alphabetical order
+func (h *Hub) ArenaSummaries() []ArenaSummary {
+	byName := make(map[string]*ArenaSummary)
+	h.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		arena, exists := byName[player.Arena]
+		if !exists {
+			arena = &ArenaSummary{Name: player.Arena}
+			byName[player.Arena] = arena
+		}
+
+		mass := int64(player.Mass())
+		arena.Players++
+		arena.TotalMass += mass
+		if mass > arena.MaxMass {
+			arena.Largest = player.Name
+			arena.MaxMass = mass
+		}
+	})
+
+	arenas := make([]ArenaSummary, 0, len(byName))
+	for _, arena := range byName {
+		arenas = append(arenas, *arena)
+	}
+	sort.Slice(arenas, func(i, j int) bool {
+		return arenas[i].Name < arenas[j].Name
+	})
+	return arenas
+}
+
+func (h *Hub) Status() ServerStatus {
+	loggedIn := 0
+	h.Clients.ForEach(func(_ uint64, client ClientInterfacer) {
+		if client.AccountId() != 0 {
+			loggedIn++
+		}
+	})
+
+	return ServerStatus{
+		UptimeSeconds: int64(time.Since(h.startedAt).Seconds()),
+		Clients:       h.Clients.Len(),
+		LoggedIn:      loggedIn,
+		Spores:        h.SharedGameObjects.Spores.Len(),
+		SporeTarget:   h.SporeTarget(),
+		Arenas:        h.ArenaSummaries(),
+	}
+}
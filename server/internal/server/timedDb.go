@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/server/db"
+	"server/internal/server/metrics"
+	"server/internal/server/tracing"
+	"strings"
+	"time"
+)
+
+// Wraps the database so every query's latency is recorded against the name it has in queries.sql, and traced as part
+// of whatever's being traced when it runs
+type timedDb struct {
+	db db.DBTX
+
+	// The span queries are traced as children of, if anything's being traced
+	activeSpan func() *tracing.Span
+}
+
+// Starts tracing the query, if there's an active span to trace it under
+func (t timedDb) startSpan(name string) *tracing.Span {
+	if t.activeSpan == nil {
+		return nil
+	}
+	return t.activeSpan().StartChild("db "+name, "query", name)
+}
+
+func (t timedDb) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	name := queryName(query)
+	defer metrics.DbQueryDuration.ObserveSince(time.Now(), name)
+	span := t.startSpan(name)
+	defer span.End()
+
+	result, err := t.db.ExecContext(ctx, query, args...)
+	span.SetError(err)
+	return result, err
+}
+
+func (t timedDb) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return t.db.PrepareContext(ctx, query)
+}
+
+func (t timedDb) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	name := queryName(query)
+	defer metrics.DbQueryDuration.ObserveSince(time.Now(), name)
+	span := t.startSpan(name)
+	defer span.End()
+
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	span.SetError(err)
+	return rows, err
+}
+
+// Only covers running the query, since the row isn't read until it's scanned
+func (t timedDb) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	name := queryName(query)
+	defer metrics.DbQueryDuration.ObserveSince(time.Now(), name)
+	span := t.startSpan(name)
+	defer span.End()
+
+	return t.db.QueryRowContext(ctx, query, args...)
+}
+
+// The name sqlc gives the query, from the comment it starts with, e.g. "GetUserByUsername"
+func queryName(query string) string {
+	name, found := strings.CutPrefix(query, "-- name: ")
+	if !found {
+		return "unknown"
+	}
+	if end := strings.IndexAny(name, " \n"); end >= 0 {
+		name = name[:end]
+	}
+	return name
+}
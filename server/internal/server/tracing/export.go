@@ -0,0 +1,240 @@
+package tracing
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The service spans are reported as coming from
+const serviceName = "game-server"
+
+// How long the OTLP exporter waits on the collector before giving up on a batch
+const otlpTimeout = 5 * time.Second
+
+// Appends spans to a file as JSON, one span per line
+type FileExporter struct {
+	path string
+	mux  sync.Mutex
+	file *os.File
+}
+
+// Opens the file to append spans to, creating it if it doesn't exist
+func NewFileExporter(path string) (*FileExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{path: path, file: file}, nil
+}
+
+func (e *FileExporter) String() string {
+	return fmt.Sprintf("file %s", e.path)
+}
+
+// A span as it's written to a trace file
+type fileSpan struct {
+	TraceId    string         `json:"trace_id"`
+	SpanId     string         `json:"span_id"`
+	ParentId   string         `json:"parent_span_id,omitempty"`
+	Name       string         `json:"name"`
+	Start      time.Time      `json:"start"`
+	End        time.Time      `json:"end"`
+	DurationMs float64        `json:"duration_ms"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+	Error      string         `json:"error,omitempty"`
+}
+
+func (e *FileExporter) Export(spans []*Span) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	writer := bufio.NewWriter(e.file)
+	encoder := json.NewEncoder(writer)
+	for _, span := range spans {
+		out := fileSpan{
+			TraceId:    span.TraceId.String(),
+			SpanId:     span.Id.String(),
+			Name:       span.Name,
+			Start:      span.StartTime,
+			End:        span.EndTime,
+			DurationMs: float64(span.EndTime.Sub(span.StartTime).Microseconds()) / 1000,
+		}
+		if !span.ParentId.IsZero() {
+			out.ParentId = span.ParentId.String()
+		}
+		if attributes := span.Attributes(); len(attributes) > 0 {
+			out.Attributes = make(map[string]any, len(attributes))
+			for _, attribute := range attributes {
+				out.Attributes[attribute.Key] = attribute.Value
+			}
+		}
+		if err := span.Err(); err != nil {
+			out.Error = err.Error()
+		}
+
+		if err := encoder.Encode(out); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// Posts spans to an OpenTelemetry collector, or anything else which accepts OTLP as JSON over HTTP
+type OtlpExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+// Posts spans to the endpoint, e.g. http://localhost:4318/v1/traces. The endpoint is checked up front, so a typo shows
+// up when the server starts rather than as every export failing.
+func NewOtlpExporter(endpoint string) (*OtlpExporter, error) {
+	if endpoint == "" {
+		return nil, errors.New("no endpoint given")
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid endpoint %q: must be an http or https URL", endpoint)
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: no host", endpoint)
+	}
+	return &OtlpExporter{endpoint: endpoint, client: &http.Client{Timeout: otlpTimeout}}, nil
+}
+
+func (e *OtlpExporter) String() string {
+	return fmt.Sprintf("OTLP collector %s", e.endpoint)
+}
+
+// The parts of the OTLP trace export request which are needed to describe our spans
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceId           string          `json:"traceId"`
+	SpanId            string          `json:"spanId"`
+	ParentSpanId      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusOk         = 1
+	otlpStatusError      = 2
+)
+
+// Wraps a value the way OTLP expects, picking the type from the value
+func otlpValue(value any) map[string]any {
+	switch value := value.(type) {
+	case string:
+		return map[string]any{"stringValue": value}
+	case bool:
+		return map[string]any{"boolValue": value}
+	case int:
+		return map[string]any{"intValue": strconv.FormatInt(int64(value), 10)}
+	case int32:
+		return map[string]any{"intValue": strconv.FormatInt(int64(value), 10)}
+	case int64:
+		return map[string]any{"intValue": strconv.FormatInt(value, 10)}
+	case uint64:
+		return map[string]any{"intValue": strconv.FormatUint(value, 10)}
+	case float32:
+		return map[string]any{"doubleValue": float64(value)}
+	case float64:
+		return map[string]any{"doubleValue": value}
+	default:
+		return map[string]any{"stringValue": fmt.Sprint(value)}
+	}
+}
+
+func (e *OtlpExporter) Export(spans []*Span) error {
+	out := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		out[i] = otlpSpan{
+			TraceId:           span.TraceId.String(),
+			SpanId:            span.Id.String(),
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			Status:            otlpStatus{Code: otlpStatusOk},
+		}
+		if !span.ParentId.IsZero() {
+			out[i].ParentSpanId = span.ParentId.String()
+		}
+		for _, attribute := range span.Attributes() {
+			out[i].Attributes = append(out[i].Attributes, otlpAttribute{Key: attribute.Key, Value: otlpValue(attribute.Value)})
+		}
+		if err := span.Err(); err != nil {
+			out[i].Status = otlpStatus{Code: otlpStatusError, Message: err.Error()}
+		}
+	}
+
+	body, err := json.Marshal(otlpRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource: otlpResource{Attributes: []otlpAttribute{
+			{Key: "service.name", Value: otlpValue(serviceName)},
+		}},
+		ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: "server/internal/server/tracing"}, Spans: out}},
+	}}})
+	if err != nil {
+		return err
+	}
+
+	response, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("collector responded with %s", response.Status)
+	}
+	return nil
+}
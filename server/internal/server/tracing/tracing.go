@@ -0,0 +1,330 @@
+// Package tracing follows packets through the server as traces made of spans, e.g. from a client's packet being
+// decoded, through the state handling it and the database queries it runs, to the broadcasts it causes. Tracing is off
+// until an exporter is set up, and only a sample of packets are traced after that.
+//
+// Every method of a span is safe to call on nil, which is what's used for anything not being traced, so callers don't
+// need to check whether they're tracing before starting children or adding attributes.
+package tracing
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"server/internal/server/logging"
+	"server/internal/server/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var tracingLogger = logging.Subsystem("tracing")
+
+const (
+	// How many ended spans can be waiting to be exported before more are dropped
+	queueSize = 4096
+
+	// The most spans handed to the exporter at once
+	batchSize = 512
+
+	// How often spans are exported, if there aren't enough to fill a batch sooner
+	flushInterval = time.Second
+)
+
+var (
+	spansExported = metrics.NewCounter("tracing_spans_exported_total", "Spans handed to the trace exporter")
+	spansDropped  = metrics.NewCounter("tracing_spans_dropped_total", "Spans dropped because the export queue was full or the exporter failed")
+)
+
+// Sends ended spans somewhere they can be looked at
+type Exporter interface {
+	// A short description of where the spans go, e.g. "file /data/traces.jsonl"
+	String() string
+
+	Export(spans []*Span) error
+}
+
+type TraceId [16]byte
+
+func (id TraceId) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+type SpanId [8]byte
+
+func (id SpanId) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id SpanId) IsZero() bool {
+	return id == SpanId{}
+}
+
+// An attribute of a span, e.g. the ID of the client handling a packet
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// A piece of work done as part of a trace. Spans are created with StartTrace or StartChild, and exported once End is
+// called on them.
+type Span struct {
+	TraceId   TraceId
+	Id        SpanId
+	ParentId  SpanId
+	Name      string
+	StartTime time.Time
+	EndTime   time.Time
+
+	mux        sync.Mutex
+	attributes []Attribute
+	err        error
+	ended      bool
+}
+
+// How packets are picked to be traced
+type Sampling struct {
+	// The fraction of packets to trace, from 0 for none to 1 for all
+	Rate float64 `json:"rate"`
+
+	// The message types to always trace, e.g. "SporeConsumed", whatever the rate
+	Always []string `json:"always,omitempty"`
+}
+
+func (s Sampling) validate() error {
+	if s.Rate < 0 || s.Rate > 1 {
+		return fmt.Errorf("sample rate must be between 0 and 1, got %g", s.Rate)
+	}
+	return nil
+}
+
+type sampler struct {
+	Sampling
+	always map[string]bool
+}
+
+func newSampler(sampling Sampling) *sampler {
+	always := make(map[string]bool, len(sampling.Always))
+	for _, messageType := range sampling.Always {
+		always[messageType] = true
+	}
+	return &sampler{Sampling: sampling, always: always}
+}
+
+func (s *sampler) sample(messageType string) bool {
+	if s.always[messageType] {
+		return true
+	}
+	return s.Rate > 0 && rand.Float64() < s.Rate
+}
+
+var (
+	// The exporter spans are queued for, or nil if tracing is off
+	exporter atomic.Pointer[Exporter]
+
+	currentSampler atomic.Pointer[sampler]
+
+	queue = make(chan *Span, queueSize)
+
+	startExportLoop sync.Once
+)
+
+func init() {
+	currentSampler.Store(newSampler(Sampling{}))
+}
+
+// Turns tracing on, sampling packets as given and sending the spans to the exporter. Calling it again switches to the
+// new exporter.
+func Setup(newExporter Exporter, sampling Sampling) error {
+	if newExporter == nil {
+		return errors.New("no exporter given")
+	}
+	if err := SetSampling(sampling); err != nil {
+		return err
+	}
+
+	exporter.Store(&newExporter)
+	startExportLoop.Do(func() {
+		go exportLoop(flushInterval)
+	})
+
+	tracingLogger.Printf("Tracing to %s, sampling %g of packets", newExporter, sampling.Rate)
+	return nil
+}
+
+// Whether tracing has been set up
+func Enabled() bool {
+	return exporter.Load() != nil
+}
+
+// Where spans are being exported to, or an empty string if tracing is off
+func ExporterName() string {
+	if current := exporter.Load(); current != nil {
+		return (*current).String()
+	}
+	return ""
+}
+
+// Changes how packets are picked to be traced, taking effect for the next packet
+func SetSampling(sampling Sampling) error {
+	if err := sampling.validate(); err != nil {
+		return err
+	}
+	currentSampler.Store(newSampler(sampling))
+	return nil
+}
+
+func CurrentSampling() Sampling {
+	return currentSampler.Load().Sampling
+}
+
+// Starts a new trace for handling a message of the type, which started at the given time, if tracing is on and the
+// message is sampled. Otherwise returns nil, which can be used like any other span.
+func StartTrace(messageType string, name string, start time.Time, args ...any) *Span {
+	if !Enabled() || !currentSampler.Load().sample(messageType) {
+		return nil
+	}
+
+	span := &Span{Name: name, StartTime: start, Id: newSpanId()}
+	binary.BigEndian.PutUint64(span.TraceId[:8], rand.Uint64())
+	binary.BigEndian.PutUint64(span.TraceId[8:], rand.Uint64())
+	span.SetAttributes(append([]any{"message", messageType}, args...)...)
+	return span
+}
+
+// Starts a span for work done as part of this one, given attributes as alternating keys and values
+func (s *Span) StartChild(name string, args ...any) *Span {
+	if s == nil {
+		return nil
+	}
+
+	child := &Span{TraceId: s.TraceId, ParentId: s.Id, Id: newSpanId(), Name: name, StartTime: time.Now()}
+	child.SetAttributes(args...)
+	return child
+}
+
+// Records a child span for work which has already been done, having started at the given time and finished now
+func (s *Span) AddChild(name string, start time.Time, args ...any) {
+	if s == nil {
+		return
+	}
+
+	child := s.StartChild(name, args...)
+	child.StartTime = start
+	child.End()
+}
+
+// Adds attributes, given as alternating keys and values
+func (s *Span) SetAttributes(args ...any) {
+	if s == nil {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for i := 0; i+1 < len(args); i += 2 {
+		key, ok := args[i].(string)
+		if !ok {
+			key = fmt.Sprint(args[i])
+		}
+		s.attributes = append(s.attributes, Attribute{Key: key, Value: args[i+1]})
+	}
+}
+
+func (s *Span) Attributes() []Attribute {
+	if s == nil {
+		return nil
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]Attribute{}, s.attributes...)
+}
+
+// Marks the span as failed, unless the error is nil
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.err = err
+}
+
+func (s *Span) Err() error {
+	if s == nil {
+		return nil
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.err
+}
+
+// Ends the span and queues it to be exported. Only the first call does anything.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.mux.Lock()
+	if s.ended {
+		s.mux.Unlock()
+		return
+	}
+	s.ended = true
+	s.EndTime = time.Now()
+	s.mux.Unlock()
+
+	select {
+	case queue <- s:
+	default:
+		spansDropped.Inc()
+	}
+}
+
+func newSpanId() SpanId {
+	var id SpanId
+	binary.BigEndian.PutUint64(id[:], rand.Uint64())
+	return id
+}
+
+// Hands queued spans to the exporter in batches, whenever there's a full batch or the rate passes
+func exportLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	batch := make([]*Span, 0, batchSize)
+	for {
+		select {
+		case span := <-queue:
+			batch = append(batch, span)
+			if len(batch) < batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		export(batch)
+		batch = make([]*Span, 0, batchSize)
+	}
+}
+
+func export(batch []*Span) {
+	current := exporter.Load()
+	if current == nil {
+		return
+	}
+
+	if err := (*current).Export(batch); err != nil {
+		tracingLogger.Warnf("Failed to export %d spans to %s: %v", len(batch), *current, err)
+		spansDropped.Add(float64(len(batch)))
+		return
+	}
+	spansExported.Add(float64(len(batch)))
+}
@@ -12,6 +12,16 @@ func NewChat(msg string) Msg {
 	}
 }
 
+// A chat message from the server itself rather than any player
+func NewSystemMessage(msg string) Msg {
+	return &Packet_Chat{
+		Chat: &ChatMessage{
+			Msg:     msg,
+			Channel: ChatChannel_CHAT_CHANNEL_SYSTEM,
+		},
+	}
+}
+
 func NewId(id uint64) Msg {
 	return &Packet_Id{
 		Id: &IdMessage{
@@ -45,6 +55,8 @@ func NewPlayer(id uint64, player *objects.Player) Msg {
 			Direction: player.Direction,
 			Speed:     player.Speed,
 			Color:     player.Color,
+			Badge:     player.Badge,
+			Level:     uint32(player.Level),
 		},
 	}
 }
@@ -77,10 +89,23 @@ func NewSporesBatch(spores map[uint64]*objects.Spore) Msg {
 	}
 }
 
-func NewHiscoreBoard(hiscores []*HiscoreMessage) Msg {
+func NewHiscoreBoard(hiscores []*HiscoreMessage, period HiscorePeriod, season string, offset, total int64) Msg {
 	return &Packet_HiscoreBoard{
 		HiscoreBoard: &HiscoreBoardMessage{
 			Hiscores: hiscores,
+			Period:   period,
+			Season:   season,
+			Offset:   uint64(offset),
+			Total:    uint64(total),
+		},
+	}
+}
+
+func NewHiscoreSearchResults(query string, matches []*HiscoreMessage) Msg {
+	return &Packet_HiscoreSearchResults{
+		HiscoreSearchResults: &HiscoreSearchResultsMessage{
+			Query:   query,
+			Matches: matches,
 		},
 	}
 }
@@ -92,3 +117,110 @@ func NewDisconnect(reason string) Msg {
 		},
 	}
 }
+
+func NewDataExport(json string) Msg {
+	return &Packet_DataExport{
+		DataExport: &DataExportMessage{
+			Json: json,
+		},
+	}
+}
+
+func NewCommandResponse(command string, success bool, lines []string) Msg {
+	return &Packet_CommandResponse{
+		CommandResponse: &CommandResponseMessage{
+			Command: command,
+			Success: success,
+			Lines:   lines,
+		},
+	}
+}
+
+func NewChatLog(entries []*ChatLogEntryMessage) Msg {
+	return &Packet_ChatLog{
+		ChatLog: &ChatLogMessage{
+			Entries: entries,
+		},
+	}
+}
+
+func NewBlockList(names []string) Msg {
+	return &Packet_BlockList{
+		BlockList: &BlockListMessage{
+			Names: names,
+		},
+	}
+}
+
+func NewAddFriendRequest(name string) Msg {
+	return &Packet_AddFriendRequest{
+		AddFriendRequest: &AddFriendRequestMessage{
+			Name: name,
+		},
+	}
+}
+
+func NewAcceptFriendRequest(name string) Msg {
+	return &Packet_AcceptFriendRequest{
+		AcceptFriendRequest: &AcceptFriendRequestMessage{
+			Name: name,
+		},
+	}
+}
+
+func NewRemoveFriendRequest(name string) Msg {
+	return &Packet_RemoveFriendRequest{
+		RemoveFriendRequest: &RemoveFriendRequestMessage{
+			Name: name,
+		},
+	}
+}
+
+func NewFriendPresence(name string, online bool, arena string) Msg {
+	return &Packet_FriendPresence{
+		FriendPresence: &FriendPresenceMessage{
+			Name:   name,
+			Online: online,
+			Arena:  arena,
+		},
+	}
+}
+
+func NewFriendList(friends []*FriendPresenceMessage, incomingRequests, outgoingRequests []string) Msg {
+	return &Packet_FriendList{
+		FriendList: &FriendListMessage{
+			Friends:          friends,
+			IncomingRequests: incomingRequests,
+			OutgoingRequests: outgoingRequests,
+		},
+	}
+}
+
+func NewLeaderboard(entries []*LeaderboardEntryMessage, ownRank, ownMass uint64, playerCount int) Msg {
+	return &Packet_Leaderboard{
+		Leaderboard: &LeaderboardMessage{
+			Entries:     entries,
+			OwnRank:     ownRank,
+			OwnMass:     ownMass,
+			PlayerCount: uint32(playerCount),
+		},
+	}
+}
+
+func NewAchievementList(name string, achievements []*AchievementMessage) Msg {
+	return &Packet_AchievementList{
+		AchievementList: &AchievementListMessage{
+			Name:         name,
+			Achievements: achievements,
+		},
+	}
+}
+
+func NewAchievementUnlocked(name string, achievement *AchievementMessage) Msg {
+	return &Packet_AchievementUnlocked{
+		AchievementUnlocked: &AchievementUnlockedMessage{
+			Name:        name,
+			Achievement: achievement,
+		},
+	}
+}